@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	disposablerequestv1alpha1 "github.com/crossplane-contrib/provider-http/apis/disposablerequest/v1alpha2"
+	networkv1alpha1 "github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
 	requestv1alpha1 "github.com/crossplane-contrib/provider-http/apis/request/v1alpha2"
 	httpv1alpha1 "github.com/crossplane-contrib/provider-http/apis/v1alpha1"
 )
@@ -31,6 +32,7 @@ func init() {
 		httpv1alpha1.SchemeBuilder.AddToScheme,
 		disposablerequestv1alpha1.SchemeBuilder.AddToScheme,
 		requestv1alpha1.SchemeBuilder.AddToScheme,
+		networkv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
@@ -0,0 +1,430 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AnnotationKeyPaused freezes a PortOrder while set to "true". The order is
+// neither observed, created, updated nor deleted at the backend.
+const AnnotationKeyPaused = "portorder.example.com/paused"
+
+// AnnotationKeyPrivileged permits a PortOrder to open ports in the reserved
+// port ranges of the provider while set to "true".
+const AnnotationKeyPrivileged = "portorder.example.com/privileged"
+
+// AnnotationKeyForceResync submits a PortOrder to the backend again whenever
+// its value changes, e.g. to a timestamp, even though its spec did not.
+const AnnotationKeyForceResync = "portorder.example.com/force-resync"
+
+// LabelKeyGroup identifies the PortOrders of a group by their GroupID. It is
+// set by the controller.
+const LabelKeyGroup = "portorder.example.com/group"
+
+// TypePaused indicates whether a PortOrder is paused by AnnotationKeyPaused.
+const TypePaused xpv1.ConditionType = "Paused"
+
+// TypeApproved indicates whether all approvals of a PortOrder are done.
+const TypeApproved xpv1.ConditionType = "Approved"
+
+// TypeExternalChangeDetected indicates whether the backend reports different
+// ports for a PortOrder than desired, e.g. because the rule was edited in the
+// backend console.
+const TypeExternalChangeDetected xpv1.ConditionType = "ExternalChangeDetected"
+
+// TypeConnectBackoff indicates whether connecting to the backend for a
+// PortOrder failed, e.g. because its credentials are misconfigured, and is
+// retried with an increasing delay.
+const TypeConnectBackoff xpv1.ConditionType = "ConnectBackoff"
+
+// Reasons connecting to the backend for a PortOrder is or is not backed off.
+const (
+	ReasonConnectFailed xpv1.ConditionReason = "ConnectFailed"
+	ReasonConnected     xpv1.ConditionReason = "Connected"
+)
+
+// TypeBackendDeprecated indicates whether the backend announced that the
+// endpoint of a PortOrder is deprecated, e.g. because its API version is
+// being retired.
+const TypeBackendDeprecated xpv1.ConditionType = "BackendDeprecated"
+
+// Reasons the endpoint of a PortOrder is or is not deprecated.
+const (
+	ReasonDeprecationAnnounced xpv1.ConditionReason = "DeprecationAnnounced"
+	ReasonNotDeprecated        xpv1.ConditionReason = "NotDeprecated"
+)
+
+// TypePortMismatch indicates whether the backend opened other ports for a
+// PortOrder than desired, e.g. because it silently dropped some of them.
+const TypePortMismatch xpv1.ConditionType = "PortMismatch"
+
+// Reasons the ports of a PortOrder do or do not match at the backend.
+const (
+	ReasonPortsChanged  xpv1.ConditionReason = "PortsChanged"
+	ReasonPortsMatch    xpv1.ConditionReason = "PortsMatch"
+	ReasonPortsMismatch xpv1.ConditionReason = "PortsMismatch"
+)
+
+// Reasons a PortOrder is or is not approved.
+const (
+	ReasonApprovalsDone    xpv1.ConditionReason = "ApprovalsDone"
+	ReasonApprovalsPending xpv1.ConditionReason = "ApprovalsPending"
+)
+
+// Reasons a PortOrder is paused or resumed.
+const (
+	ReasonPausedByAnnotation xpv1.ConditionReason = "PausedByAnnotation"
+	ReasonResumed            xpv1.ConditionReason = "Resumed"
+)
+
+// Reasons a PortOrder is not ready.
+const (
+	ReasonWaitingForDependencies      xpv1.ConditionReason = "WaitingForDependencies"
+	ReasonWaitingForMaintenanceWindow xpv1.ConditionReason = "WaitingForMaintenanceWindow"
+	ReasonWaitingForGroup             xpv1.ConditionReason = "WaitingForGroup"
+	ReasonOrderFailed                 xpv1.ConditionReason = "OrderFailed"
+	ReasonOrderRejected               xpv1.ConditionReason = "OrderRejected"
+	ReasonOrderNotSubmitted           xpv1.ConditionReason = "OrderNotSubmitted"
+	ReasonInvalidEndpoint             xpv1.ConditionReason = "InvalidEndpoint"
+	ReasonQueued                      xpv1.ConditionReason = "Queued"
+	ReasonQueueRejected               xpv1.ConditionReason = "QueueRejected"
+	ReasonJobFailed                   xpv1.ConditionReason = "JobFailed"
+	ReasonPortsReserved               xpv1.ConditionReason = "PortsReserved"
+	ReasonExpired                     xpv1.ConditionReason = "Expired"
+)
+
+// WaitingForDependencies returns a condition that indicates the PortOrder is
+// not submitted until the named PortOrders have succeeded.
+func WaitingForDependencies(names []string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonWaitingForDependencies,
+		Message:            "waiting for PortOrders: " + strings.Join(names, ", "),
+	}
+}
+
+// WaitingForGroup returns a condition that indicates the PortOrder has
+// succeeded, but is not ready until the named PortOrders of its group have
+// succeeded too.
+func WaitingForGroup(names []string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonWaitingForGroup,
+		Message:            "waiting for PortOrders of the group: " + strings.Join(names, ", "),
+	}
+}
+
+// OrderFailed returns a condition that indicates the backend reports the
+// PortOrder in a terminal failed status, for the reason it gave if any.
+func OrderFailed(status, reason string) xpv1.Condition {
+	msg := "order failed with status " + status
+	if reason != "" {
+		msg += ": " + reason
+	}
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonOrderFailed,
+		Message:            msg,
+	}
+}
+
+// OrderRejected returns a condition that indicates the orders API rejected
+// the PortOrder with a status code configured as terminal.
+func OrderRejected(code int) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonOrderRejected,
+		Message:            fmt.Sprintf("orders API rejected the order with terminal status code %d", code),
+	}
+}
+
+// OrderNotSubmitted returns a condition that indicates the PortOrder was not
+// submitted to the orders API for the supplied reason, which no retry fixes.
+func OrderNotSubmitted(reason string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonOrderNotSubmitted,
+		Message:            "order was not submitted: " + reason,
+	}
+}
+
+// InvalidEndpoint returns a condition that indicates the PortOrder is not
+// read from the orders API because the endpoint it is read from is invalid.
+func InvalidEndpoint(reason string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonInvalidEndpoint,
+		Message:            "order is not observed: " + reason,
+	}
+}
+
+// Queued returns a condition that indicates the PortOrder is queued at the
+// backend as the job with the supplied ticket, in the supplied state.
+func Queued(ticket, state string) xpv1.Condition {
+	msg := "order is queued as ticket " + ticket
+	if state != "" {
+		msg += " in state " + state
+	}
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonQueued,
+		Message:            msg,
+	}
+}
+
+// QueueRejected returns a condition that indicates the queue of the backend
+// rejected the PortOrder, for the reason it gave if any.
+func QueueRejected(reason string) xpv1.Condition {
+	msg := "order queue rejected the order"
+	if reason != "" {
+		msg += ": " + reason
+	}
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonQueueRejected,
+		Message:            msg,
+	}
+}
+
+// JobFailed returns a condition that indicates the job the PortOrder was
+// queued as failed, for the reason it gave if any.
+func JobFailed(reason string) xpv1.Condition {
+	msg := "queued order job failed"
+	if reason != "" {
+		msg += ": " + reason
+	}
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonJobFailed,
+		Message:            msg,
+	}
+}
+
+// PortsReserved returns a condition that indicates the PortOrder is not
+// submitted because the supplied ports are in reserved port ranges, and it
+// is not privileged.
+func PortsReserved(ports []string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPortsReserved,
+		Message:            fmt.Sprintf("ports %s are reserved and require the %s annotation", strings.Join(ports, ", "), AnnotationKeyPrivileged),
+	}
+}
+
+// Expired returns a condition that indicates the order of the PortOrder was
+// cancelled because it exceeded its maximum lifetime.
+func Expired(lifetime time.Duration) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonExpired,
+		Message:            "order was cancelled after exceeding its maximum lifetime of " + lifetime.String(),
+	}
+}
+
+// WaitingForMaintenanceWindow returns a condition that indicates the PortOrder
+// is not submitted while the backend is in maintenance.
+func WaitingForMaintenanceWindow() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonWaitingForMaintenanceWindow,
+		Message:            "backend maintenance is active",
+	}
+}
+
+// Approved returns a condition that indicates all approvals of the PortOrder
+// are done.
+func Approved() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeApproved,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonApprovalsDone,
+	}
+}
+
+// ApprovalsPending returns a condition that indicates the PortOrder waits
+// for the supplied number of its approvals.
+func ApprovalsPending(pending, total int) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeApproved,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonApprovalsPending,
+		Message:            fmt.Sprintf("%d of %d approvals pending", pending, total),
+	}
+}
+
+// Paused returns a condition that indicates the PortOrder is frozen by its
+// paused annotation.
+func Paused() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePaused,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPausedByAnnotation,
+		Message:            "reconciliation is paused by the " + AnnotationKeyPaused + " annotation",
+	}
+}
+
+// Resumed returns a condition that indicates the PortOrder is reconciled
+// again after being paused.
+func Resumed() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePaused,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonResumed,
+	}
+}
+
+// ExternalChangeDetected returns a condition that indicates the backend
+// reports the supplied ports for the PortOrder rather than the desired ones.
+func ExternalChangeDetected(observed, desired []string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeExternalChangeDetected,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPortsChanged,
+		Message:            fmt.Sprintf("backend has ports %s, desired are %s", strings.Join(observed, ", "), strings.Join(desired, ", ")),
+	}
+}
+
+// NoExternalChange returns a condition that indicates the backend reports the
+// desired ports for the PortOrder.
+func NoExternalChange() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeExternalChangeDetected,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPortsMatch,
+	}
+}
+
+// PortMismatch returns a condition that indicates the backend did not open
+// the missing ports of the PortOrder, and opened the extra ones it does not
+// desire.
+func PortMismatch(missing, extra []string) xpv1.Condition {
+	var msgs []string
+	if len(missing) > 0 {
+		msgs = append(msgs, "missing ports "+strings.Join(missing, ", "))
+	}
+	if len(extra) > 0 {
+		msgs = append(msgs, "extra ports "+strings.Join(extra, ", "))
+	}
+	return xpv1.Condition{
+		Type:               TypePortMismatch,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPortsMismatch,
+		Message:            "backend has " + strings.Join(msgs, "; "),
+	}
+}
+
+// PortsMatch returns a condition that indicates the backend opened exactly
+// the desired ports of the PortOrder.
+func PortsMatch() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePortMismatch,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPortsMatch,
+	}
+}
+
+// ConnectBackoff returns a condition that indicates connecting to the backend
+// for the PortOrder failed the supplied number of times in a row with the
+// supplied error, and is retried after the supplied delay.
+func ConnectBackoff(failures int, delay time.Duration, msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeConnectBackoff,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonConnectFailed,
+		Message:            fmt.Sprintf("connecting failed %d times in a row, retrying in %s: %s", failures, delay, msg),
+	}
+}
+
+// Connected returns a condition that indicates connecting to the backend for
+// the PortOrder succeeded again after it failed.
+func Connected() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeConnectBackoff,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonConnected,
+	}
+}
+
+// BackendDeprecated returns a condition that indicates the backend announced
+// that the supplied endpoint is deprecated, and retired at the supplied time
+// if it is not nil.
+func BackendDeprecated(endpoint string, sunset *time.Time) xpv1.Condition {
+	msg := "orders API endpoint " + endpoint + " is deprecated"
+	if sunset != nil {
+		msg += " and will be retired at " + sunset.UTC().Format(time.RFC3339)
+	}
+	return xpv1.Condition{
+		Type:               TypeBackendDeprecated,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDeprecationAnnounced,
+		Message:            msg,
+	}
+}
+
+// BackendNotDeprecated returns a condition that indicates the backend no
+// longer announces that the endpoint of the PortOrder is deprecated.
+func BackendNotDeprecated() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeBackendDeprecated,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNotDeprecated,
+	}
+}
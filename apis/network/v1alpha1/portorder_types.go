@@ -20,7 +20,6 @@ import (
 	"reflect"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -28,14 +27,323 @@ import (
 
 // PortParameters defines the port configuration
 type PortParameters struct {
-	// Type is the protocol type (tcp, udp)
-	// +kubebuilder:validation:Enum=tcp;udp
-	Type string `json:"type"`
+	// Type is the protocol type (tcp, udp, icmp). Defaults to tcp.
+	// +kubebuilder:validation:Enum=tcp;udp;icmp
+	// +kubebuilder:default=tcp
+	// +optional
+	Type string `json:"type,omitempty"`
 
-	// Number is the port number
-	// +kubebuilder:validation:Minimum=1
+	// Number is the port number. It is required for tcp and udp ports, and
+	// must not be set for icmp.
+	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Maximum=65535
-	Number int `json:"number"`
+	// +optional
+	Number int `json:"number,omitempty"`
+
+	// Label is a short name for the port, e.g. "jenkins-agent".
+	// +optional
+	Label string `json:"label,omitempty"`
+
+	// Comment is a free-form note about the port.
+	// +optional
+	Comment string `json:"comment,omitempty"`
+}
+
+// ResponseFieldMatch matches a field of a JSON response body against an
+// expected value.
+type ResponseFieldMatch struct {
+	// Path is the dot-separated path of the field, e.g. "result.success".
+	// +kubebuilder:validation:MinLength=1
+	Path string `json:"path"`
+
+	// Value is the expected value of the field, compared against its string
+	// form (e.g. "true", "42", "accepted").
+	Value string `json:"value"`
+}
+
+// ConfigMapKeySelector selects a key of a ConfigMap.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Key within the ConfigMap.
+	Key string `json:"key"`
+}
+
+// RequestSchema is a JSON Schema the create request body must conform to.
+// Exactly one of Inline and ConfigMapRef should be set.
+type RequestSchema struct {
+	// Inline is the JSON Schema document.
+	// +optional
+	Inline string `json:"inline,omitempty"`
+
+	// ConfigMapRef selects the ConfigMap key holding the JSON Schema
+	// document.
+	// +optional
+	ConfigMapRef *ConfigMapKeySelector `json:"configMapRef,omitempty"`
+}
+
+// MaintenanceCheck describes how to find out whether the backend is in
+// maintenance.
+type MaintenanceCheck struct {
+	// Endpoint returns the maintenance status of the backend. Maintenance is
+	// also considered active while it returns 503 Service Unavailable.
+	Endpoint string `json:"endpoint"`
+
+	// ActiveField matches the maintenance status response while maintenance
+	// is active. Defaults to an "active" field of "true".
+	// +optional
+	ActiveField *ResponseFieldMatch `json:"activeField,omitempty"`
+}
+
+// QuotaCheck describes how to find out the remaining rule quota of the
+// tenant.
+type QuotaCheck struct {
+	// Endpoint returns the rule quota of the tenant.
+	Endpoint string `json:"endpoint"`
+
+	// RemainingPath is the dot-separated path of the number of rules that
+	// can still be created in the quota response. Defaults to "remaining".
+	// +optional
+	RemainingPath string `json:"remainingPath,omitempty"`
+
+	// LimitPath is the dot-separated path of the total number of rules
+	// allowed in the quota response. Defaults to "limit".
+	// +optional
+	LimitPath string `json:"limitPath,omitempty"`
+}
+
+// DeleteConfirmation describes the confirmation token the backend requires
+// with a cancellation, read from the order at its observe endpoint right
+// before it is cancelled.
+type DeleteConfirmation struct {
+	// TokenPath is the dot-separated path of the token in the order read from
+	// the backend. Defaults to "deleteToken".
+	// +optional
+	TokenPath string `json:"tokenPath,omitempty"`
+
+	// Header is the request header the token is sent in. Defaults to
+	// "X-Delete-Token".
+	// +optional
+	Header string `json:"header,omitempty"`
+}
+
+// OrderQueue describes a backend that queues submitted orders as jobs,
+// responding with a ticket whose result is read later.
+type OrderQueue struct {
+	// ResultsEndpoint is the endpoint job results are read from, by
+	// appending the ticket to it.
+	ResultsEndpoint string `json:"resultsEndpoint"`
+
+	// TicketPath is the dot-separated path of the ticket in the create
+	// response. Defaults to "ticket".
+	// +optional
+	TicketPath string `json:"ticketPath,omitempty"`
+
+	// StatePath is the dot-separated path of the job state in the result.
+	// Defaults to "state". A job is done once its state is completed, which
+	// is when the result identifies the order like a create response does.
+	// Jobs that are rejected by the queue, or that failed, are not
+	// submitted again until the spec of the PortOrder changes. Any other
+	// state is pending.
+	// +optional
+	StatePath string `json:"statePath,omitempty"`
+}
+
+// PollBackoff describes how the poll interval of an order grows while the
+// order stays in a status that is neither ready nor failed.
+type PollBackoff struct {
+	// Base is the poll interval right after the status of the order changed.
+	// Defaults to 30s.
+	// +optional
+	Base *metav1.Duration `json:"base,omitempty"`
+
+	// Factor multiplies the poll interval at each observation of the order
+	// in an unchanged status. Defaults to 2.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Factor *int64 `json:"factor,omitempty"`
+
+	// Cap is the longest poll interval. Defaults to 30m.
+	// +optional
+	Cap *metav1.Duration `json:"cap,omitempty"`
+}
+
+// StatusCodeAction maps response status codes of the orders API to how they
+// are handled.
+type StatusCodeAction struct {
+	// Codes is a status code, e.g. "422", an inclusive range of status
+	// codes, e.g. "500-504", or a class of status codes, e.g. "4xx".
+	// +kubebuilder:validation:Pattern=`^([1-5][0-9][0-9](-[1-5][0-9][0-9])?|[1-5]xx)$`
+	Codes string `json:"codes"`
+
+	// Action is how responses with the codes are handled. A success is
+	// accepted and its body read. With notExists, the order is considered
+	// not to exist at the backend. With retry, the operation fails and is
+	// retried. With terminal, the operation fails permanently: a rejected
+	// create is not submitted again until the spec of the PortOrder changes,
+	// and an order that cannot be read is reported as rejected rather than
+	// failing each observation. Cancellations are still retried, since the
+	// order cannot be assumed to be gone.
+	// +kubebuilder:validation:Enum=success;retry;terminal;notExists
+	Action string `json:"action"`
+}
+
+// TimeoutEscalation describes how a create request that timed out is retried
+// with longer timeouts.
+type TimeoutEscalation struct {
+	// Timeout is the timeout of the first attempt. Attempts never take longer
+	// than the timeout of the credentials.
+	Timeout metav1.Duration `json:"timeout"`
+
+	// FactorPercent is the timeout of each further attempt as a percentage of
+	// the timeout of the attempt before it. Defaults to 150.
+	// +kubebuilder:validation:Minimum=100
+	// +optional
+	FactorPercent *int `json:"factorPercent,omitempty"`
+
+	// MaxTimeout caps the timeout of an attempt.
+	// +optional
+	MaxTimeout *metav1.Duration `json:"maxTimeout,omitempty"`
+
+	// MaxAttempts is the number of attempts made before the timeout is
+	// returned. Defaults to 3.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxAttempts *int `json:"maxAttempts,omitempty"`
+}
+
+// EventSink describes an endpoint order lifecycle events are published to.
+type EventSink struct {
+	// Endpoint receives each event as a JSON POST request.
+	Endpoint string `json:"endpoint"`
+
+	// CredentialsSecretRef references the value of the Authorization header
+	// sent with events. No Authorization header is sent if unset.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// Notification describes a chat webhook notified when an order is ready or
+// failed.
+type Notification struct {
+	// WebhookURLSecretRef references the URL of the webhook. It is read from
+	// a secret, since webhook URLs usually embed their credentials.
+	WebhookURLSecretRef xpv1.SecretKeySelector `json:"webhookURLSecretRef"`
+
+	// SuccessTemplate is the Go template of the message sent when the order
+	// is ready. It is rendered with the PortOrder, e.g.
+	// {{ .Spec.ForProvider.Source }}. A default message is sent if unset.
+	// +optional
+	SuccessTemplate string `json:"successTemplate,omitempty"`
+
+	// FailureTemplate is the Go template of the message sent when the order
+	// failed. It is rendered with the PortOrder, e.g.
+	// {{ .Status.AtProvider.StatusReason }}. A default message is sent if
+	// unset.
+	// +optional
+	FailureTemplate string `json:"failureTemplate,omitempty"`
+}
+
+// Traffic directions of an order.
+const (
+	DirectionIngress = "ingress"
+	DirectionEgress  = "egress"
+	DirectionBoth    = "both"
+)
+
+// Environments an order may target.
+const (
+	EnvironmentDev   = "dev"
+	EnvironmentStage = "stage"
+	EnvironmentProd  = "prod"
+)
+
+// Formats of the port protocols sent to the orders backend.
+const (
+	ProtocolFormatName   = "name"
+	ProtocolFormatNumber = "number"
+)
+
+// Policies for ports changed at the backend outside of the PortOrder.
+const (
+	ExternalChangePolicyWarn     = "Warn"
+	ExternalChangePolicyReassert = "Reassert"
+)
+
+// Strategies of choosing the endpoint of the orders API a request targets.
+const (
+	LoadBalanceStrategyFailover   = "failover"
+	LoadBalanceStrategyRoundRobin = "roundRobin"
+	LoadBalanceStrategyRandom     = "random"
+)
+
+// Protocol types of a port.
+const (
+	PortTypeTCP  = "tcp"
+	PortTypeUDP  = "udp"
+	PortTypeICMP = "icmp"
+)
+
+// Modes of observing an order.
+const (
+	ObserveModePoll       = "poll"
+	ObserveModeCreateOnly = "createOnly"
+)
+
+// Actions taken once an order exceeded its maximum lifetime.
+const (
+	ExpiryActionDelete = "Delete"
+	ExpiryActionCancel = "Cancel"
+)
+
+// States of a queued order job.
+const (
+	QueueStateCompleted = "completed"
+	QueueStateRejected  = "rejected"
+	QueueStateFailed    = "failed"
+)
+
+// Actions taken on a response status code.
+const (
+	StatusCodeActionSuccess   = "success"
+	StatusCodeActionRetry     = "retry"
+	StatusCodeActionTerminal  = "terminal"
+	StatusCodeActionNotExists = "notExists"
+)
+
+// Methods of checking that an order exists.
+const (
+	ExistenceCheckMethodGET  = "GET"
+	ExistenceCheckMethodHEAD = "HEAD"
+)
+
+// Protocols supported by the orders backend.
+const (
+	ProtocolREST    = "rest"
+	ProtocolGraphQL = "graphql"
+)
+
+// GraphQLParameters are the GraphQL operations used to manage an order. Both
+// operations must select the order under the "order" response field.
+type GraphQLParameters struct {
+	// CreateMutation creates the order. It receives the order as the $order
+	// variable.
+	// +optional
+	CreateMutation string `json:"createMutation,omitempty"`
+
+	// ObserveQuery reads the order. It receives the order ID as the $id
+	// variable.
+	// +optional
+	ObserveQuery string `json:"observeQuery,omitempty"`
+
+	// CancelMutation cancels the order. It receives the order ID as the $id
+	// variable.
+	// +optional
+	CancelMutation string `json:"cancelMutation,omitempty"`
 }
 
 // PortOrderParameters are the configurable fields of a PortOrder.
@@ -50,15 +358,504 @@ type PortOrderParameters struct {
 	// +kubebuilder:validation:Pattern=`^([0-9]{1,3}\.){3}[0-9]{1,3}(/[0-9]{1,2})?$`
 	Destination string `json:"destination"`
 
+	// SkipCIDRNormalization sends Source and Destination as written. By
+	// default, CIDRs with host bits set, such as 10.0.0.5/24, are sent in
+	// their network address form, such as 10.0.0.0/24.
+	// +optional
+	SkipCIDRNormalization bool `json:"skipCidrNormalization,omitempty"`
+
 	// Ports is the list of ports to open
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinItems=1
 	Ports []PortParameters `json:"ports"`
 
+	// AllowAnyPort permits port 0, which stands for any port, on tcp and udp
+	// ports. Only set it if the backend accepts port 0.
+	// +optional
+	AllowAnyPort bool `json:"allowAnyPort,omitempty"`
+
+	// ExternalChangePolicy is what happens when the backend reports other
+	// ports for the order than desired, e.g. because the rule was edited in
+	// the backend console. Either way the ExternalChangeDetected condition is
+	// set. With Warn, a warning event is recorded and the ports are left as
+	// they are. With Reassert, the desired ports are requested again.
+	// +kubebuilder:validation:Enum=Warn;Reassert
+	// +kubebuilder:default=Warn
+	// +optional
+	ExternalChangePolicy string `json:"externalChangePolicy,omitempty"`
+
+	// Direction is the direction of the traffic the order allows.
+	// +kubebuilder:validation:Enum=ingress;egress;both
+	// +kubebuilder:default=ingress
+	// +optional
+	Direction string `json:"direction,omitempty"`
+
+	// Environment is the environment the order targets, sent to the backend
+	// with the order. It is not sent if unset.
+	// +kubebuilder:validation:Enum=dev;stage;prod
+	// +optional
+	Environment string `json:"environment,omitempty"`
+
+	// ProtocolFormat is how port protocols are sent to the backend: by name,
+	// e.g. "TCP", or by IP protocol number, e.g. 6.
+	// +kubebuilder:validation:Enum=name;number
+	// +kubebuilder:default=name
+	// +optional
+	ProtocolFormat string `json:"protocolFormat,omitempty"`
+
+	// SortPorts sends the ports sorted by protocol, then number, rather than
+	// in the order they are listed, so that equivalent orders have the same
+	// request body, e.g. for deduplication by the backend or reproducible
+	// request signatures.
+	// +optional
+	SortPorts bool `json:"sortPorts,omitempty"`
+
 	// APIEndpoint is the endpoint for the orders API
 	// +optional
 	// +kubebuilder:default="https://api.example.com/orders"
 	APIEndpoint string `json:"apiEndpoint,omitempty"`
+
+	// Endpoints are further endpoints of the orders API equivalent to
+	// APIEndpoint, e.g. regional gateways. Requests to APIEndpoint are sent
+	// to it or one of them according to the LoadBalanceStrategy, replacing
+	// the APIEndpoint prefix of the request URL.
+	// +optional
+	Endpoints []string `json:"endpoints,omitempty"`
+
+	// LoadBalanceStrategy is how the endpoint of a request is chosen from
+	// APIEndpoint and Endpoints. With failover, APIEndpoint is chosen unless
+	// it is unavailable. With roundRobin, the endpoints take turns across
+	// all PortOrders with the same endpoints. With random, an endpoint is
+	// chosen at random. Either way, a request that fails because its
+	// endpoint is unavailable is sent to the next one. Write requests are
+	// only sent to the next one if they could not be sent at all.
+	// +kubebuilder:validation:Enum=failover;roundRobin;random
+	// +kubebuilder:default=failover
+	// +optional
+	LoadBalanceStrategy string `json:"loadBalanceStrategy,omitempty"`
+
+	// TenantID is the backend tenant the order is submitted for. It is sent
+	// in the X-Tenant-ID header of every request, and is required when the
+	// provider runs in multi-tenant mode.
+	// +optional
+	TenantID string `json:"tenantId,omitempty"`
+
+	// CredentialsSecretRef references credentials that are used for this
+	// order instead of the ProviderConfig credentials. The secret key holds
+	// the same JSON document as the ProviderConfig credentials.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+
+	// JustificationSecretRef references the compliance justification of
+	// the order, which is sent as the justification field of the create
+	// request. Keeping it in a secret keeps large or sensitive text out of
+	// the PortOrder. Creation fails if it cannot be read.
+	// +optional
+	JustificationSecretRef *xpv1.SecretKeySelector `json:"justificationSecretRef,omitempty"`
+
+	// CreateEndpoint is the endpoint orders are submitted to. Defaults to
+	// APIEndpoint.
+	// +optional
+	CreateEndpoint string `json:"createEndpoint,omitempty"`
+
+	// ObserveEndpoint is the endpoint orders are read from, as
+	// <ObserveEndpoint>/<OrderID>. Defaults to APIEndpoint.
+	// +optional
+	ObserveEndpoint string `json:"observeEndpoint,omitempty"`
+
+	// MaxResponseBytes is the maximum size of a response body read from the
+	// orders API. Larger responses are rejected. Defaults to 10MiB.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxResponseBytes *int64 `json:"maxResponseBytes,omitempty"`
+
+	// MaxRequestBytes is the maximum size of the create request body. Orders
+	// with larger bodies fail without being submitted until their spec
+	// changes, since the orders API would reject them. Defaults to 1MiB, the
+	// limit of the orders API.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxRequestBytes *int64 `json:"maxRequestBytes,omitempty"`
+
+	// MaxReceiptBytes is the maximum size of the signed receipt of the order
+	// stored in the status. Larger receipts are not stored. Defaults to
+	// 64KiB.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxReceiptBytes *int64 `json:"maxReceiptBytes,omitempty"`
+
+	// SuccessField, when set, must match the create response body for the
+	// order to be considered created, in addition to a successful status code.
+	// +optional
+	SuccessField *ResponseFieldMatch `json:"successField,omitempty"`
+
+	// ErrorMessagePath is the dot-separated path of the error message in a
+	// response body that fails the SuccessField check. Defaults to "message".
+	// +optional
+	ErrorMessagePath string `json:"errorMessagePath,omitempty"`
+
+	// StatusPath is the dot-separated path of the order status in a REST
+	// response body, e.g. "order.state.phase". Defaults to "status".
+	// +optional
+	StatusPath string `json:"statusPath,omitempty"`
+
+	// OrderIDHeader is the response header the order ID is read from when
+	// the backend creates the order but responds with an empty body, e.g.
+	// "X-Order-ID". The last path segment of the Location header is used if
+	// unset or absent.
+	// +optional
+	OrderIDHeader string `json:"orderIdHeader,omitempty"`
+
+	// DeltaCursorParameter is the query parameter the cursor of the previous
+	// read of the order is sent in, so that the backend returns only the
+	// fields that changed since, or 304 Not Modified if none did. The cursor
+	// is read from the "cursor" field of each response. The order is read in
+	// full if unset, if the backend returns no cursor, if it rejects the
+	// cursor with 400 Bad Request or 410 Gone, and for orders of several
+	// rule objects.
+	// +optional
+	DeltaCursorParameter string `json:"deltaCursorParameter,omitempty"`
+
+	// RetryOnBodyMatch matches a successful create response that asks for
+	// the order to be submitted again shortly, e.g. a "status" field of
+	// "retry". Matching orders are resubmitted a bounded number of times
+	// with backoff.
+	// +optional
+	RetryOnBodyMatch *ResponseFieldMatch `json:"retryOnBodyMatch,omitempty"`
+
+	// TimeoutEscalation retries a create request that timed out within the
+	// same reconcile, with a longer timeout each attempt. Every reconcile
+	// starts again from the first timeout.
+	// +optional
+	TimeoutEscalation *TimeoutEscalation `json:"timeoutEscalation,omitempty"`
+
+	// PrettyPrintBody sends the create request body as indented JSON, which
+	// keeps it readable for audit sinks that capture request bodies. Compact
+	// JSON is sent by default.
+	// +optional
+	PrettyPrintBody bool `json:"prettyPrintBody,omitempty"`
+
+	// PruneEmptyFields omits every field of the create request body that is
+	// null, an empty string or an empty object or list, including the
+	// required ones, for backends that strictly reject fields they do not
+	// expect. Numbers and booleans are always sent, since zero and false are
+	// meaningful, e.g. port 0 for any port. The fields of the pruned body
+	// are sent in alphabetical order. REST only.
+	// +optional
+	PruneEmptyFields bool `json:"pruneEmptyFields,omitempty"`
+
+	// EncryptPayload encrypts the body of every request to the orders API
+	// as a compact JWE (RSA-OAEP-256 and A256GCM) to the encryptionPublicKey
+	// of the credentials, sent with the content type application/jose.
+	// Responses of that content type are decrypted with the
+	// decryptionPrivateKey of the credentials. Request bodies are not
+	// logged in plain text.
+	// +optional
+	EncryptPayload bool `json:"encryptPayload,omitempty"`
+
+	// Priority orders the reconciles of pending PortOrders, i.e. those that
+	// are not yet ready, fail to sync or are being deleted. While a PortOrder
+	// is pending, the reconciles of PortOrders of a lower priority are
+	// deferred, so it is not stuck behind them. A deferred PortOrder is
+	// still reconciled after a few deferrals in a row, so no PortOrder is
+	// starved. PortOrders of the same priority are reconciled in the order
+	// they were queued. Higher values are reconciled first.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// TagLabelPrefix selects the labels of this PortOrder that are sent to the
+	// backend as order tags, e.g. "order.example.com/". The prefix is stripped
+	// from the tag names. No tags are sent when unset.
+	// +optional
+	TagLabelPrefix string `json:"tagLabelPrefix,omitempty"`
+
+	// BodyFieldAnnotationPrefix selects the annotations of this PortOrder
+	// that are sent as top-level fields of the create request body, e.g.
+	// "body.order.example.com/". The prefix is stripped from the field names.
+	// Values of true or false are sent as booleans and numeric values as
+	// numbers; quote a value to send it as a string regardless. No fields
+	// are added when unset.
+	// +optional
+	BodyFieldAnnotationPrefix string `json:"bodyFieldAnnotationPrefix,omitempty"`
+
+	// TenantAnnotation is the annotation (or label) whose value identifies
+	// the tenant of the order, such as the namespace of the owning claim. It
+	// is sent as the tenant field of the order and omitted when absent.
+	// Defaults to "crossplane.io/claim-namespace".
+	// +optional
+	TenantAnnotation string `json:"tenantAnnotation,omitempty"`
+
+	// TenantHeader, when set, sends the tenant in this request header instead
+	// of the tenant field of the order.
+	// +optional
+	TenantHeader string `json:"tenantHeader,omitempty"`
+
+	// StreamStatus consumes the server-sent events stream at
+	// <ObserveEndpoint>/<OrderID>/stream and updates the order status as
+	// events arrive, in addition to regular polling.
+	// +optional
+	StreamStatus bool `json:"streamStatus,omitempty"`
+
+	// StreamingResponse reads the create response as newline-delimited JSON,
+	// such as a progress log streamed while the order is submitted. The last
+	// complete JSON line is the result of the create, and the lines before
+	// it are recorded as its progress.
+	// +optional
+	StreamingResponse bool `json:"streamingResponse,omitempty"`
+
+	// WatchViaWebSocket subscribes to order updates over a WebSocket and
+	// updates the order status as they arrive. The order is not polled while
+	// the subscription is connected. Takes precedence over StreamStatus.
+	// +optional
+	WatchViaWebSocket bool `json:"watchViaWebSocket,omitempty"`
+
+	// WebSocketEndpoint is the WebSocket endpoint order updates are
+	// subscribed at. Defaults to ObserveEndpoint with the ws or wss scheme.
+	// +optional
+	WebSocketEndpoint string `json:"webSocketEndpoint,omitempty"`
+
+	// ConfirmCreate reads the order back right after it was created. Creation
+	// fails, and is retried, if the backend does not find it.
+	// +optional
+	ConfirmCreate bool `json:"confirmCreate,omitempty"`
+
+	// ObserveMode is how the order is observed once created. Orders are
+	// polled by default. With createOnly, the order is never read from the
+	// backend after it was created successfully. It is reported as ready,
+	// and only changes of its source or destination are acted upon.
+	// +kubebuilder:validation:Enum=poll;createOnly
+	// +kubebuilder:default=poll
+	// +optional
+	ObserveMode string `json:"observeMode,omitempty"`
+
+	// ExistenceCheckMethod is the HTTP method used to check that a ready
+	// order still exists. With HEAD, the order status is not read again once
+	// the order is ready. Orders that are not ready yet, and backends that
+	// respond to HEAD with 405 Method Not Allowed, are read with GET.
+	// +kubebuilder:validation:Enum=GET;HEAD
+	// +kubebuilder:default=GET
+	// +optional
+	ExistenceCheckMethod string `json:"existenceCheckMethod,omitempty"`
+
+	// NotFoundGracePeriod is how long after the order was submitted a
+	// backend that does not find it yet is considered not to have caught up,
+	// rather than the order being gone. Defaults to 1m.
+	// +optional
+	NotFoundGracePeriod *metav1.Duration `json:"notFoundGracePeriod,omitempty"`
+
+	// VerifyDeletion keeps a deleted order until the backend reports it as
+	// cancelled or deleted, or no longer finds it. Otherwise the order is
+	// considered deleted as soon as it was cancelled.
+	// +optional
+	VerifyDeletion bool `json:"verifyDeletion,omitempty"`
+
+	// DeleteConfirmation reads a confirmation token from the order before it
+	// is cancelled, and sends it with the cancellation. An order the backend
+	// no longer finds is considered cancelled. REST only.
+	// +optional
+	DeleteConfirmation *DeleteConfirmation `json:"deleteConfirmation,omitempty"`
+
+	// Queue submits the order to a queue that responds with a ticket rather
+	// than the order, and reads the order from the result of the queued job
+	// once it completes. The PortOrder is not ready until then. REST only.
+	// +optional
+	Queue *OrderQueue `json:"queue,omitempty"`
+
+	// FollowRedirects follows redirects of the orders API, recording where
+	// requests were last redirected to in the status, so that endpoints that
+	// moved are noticed. When false, a redirect fails the request with its
+	// target location.
+	// +kubebuilder:default=true
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+
+	// StatusCodeActions configure how the status codes of responses to
+	// creates, reads and cancellations are handled. The first entry whose
+	// codes match a response applies. Status codes no entry matches are
+	// handled as before: 2xx is a success, except that only 200 and 201
+	// are for creates, 404 means the order does not exist, except for
+	// creates, and anything else is retried.
+	// +optional
+	StatusCodeActions []StatusCodeAction `json:"statusCodeActions,omitempty"`
+
+	// MaxDeletionChecks is how often the backend is checked for a deleted
+	// order before verification fails. Defaults to 10.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxDeletionChecks *int `json:"maxDeletionChecks,omitempty"`
+
+	// ReadyStatuses are the order statuses reported by the backend that mean
+	// the order was applied successfully. Matching is case-insensitive.
+	// Defaults to active and complete.
+	// +optional
+	ReadyStatuses []string `json:"readyStatuses,omitempty"`
+
+	// HistoryLimit is the number of most recent status transitions of the
+	// order read from its history endpoint, at "history" relative to the URL
+	// of the order, and stored in the status on every observation. The
+	// history is not read unless it is set, and is skipped for backends that
+	// do not support it. REST only.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	HistoryLimit *int64 `json:"historyLimit,omitempty"`
+
+	// ReadinessPath is the path, relative to the URL of the order, of a
+	// readiness endpoint the backend exposes for each order, e.g.
+	// "readiness". If set, the order is ready once the endpoint responds
+	// with a 2xx status code for all of its rule objects, rather than once
+	// it reports one of the ReadyStatuses. FailedStatuses still apply. REST
+	// only.
+	// +optional
+	ReadinessPath string `json:"readinessPath,omitempty"`
+
+	// FailedStatuses are the order statuses reported by the backend that mean
+	// the order failed for good. Matching is case-insensitive. Defaults to
+	// failed, rejected and error.
+	// +optional
+	FailedStatuses []string `json:"failedStatuses,omitempty"`
+
+	// FailedPollInterval is how often an order in a failed status is polled,
+	// in case it is fixed manually on the backend. Defaults to 1h.
+	// +optional
+	FailedPollInterval *metav1.Duration `json:"failedPollInterval,omitempty"`
+
+	// PendingPollBackoff backs off the poll interval of an order the longer
+	// it stays in a status that is neither ready nor failed, resetting
+	// whenever its status changes. Pending orders are polled at the regular
+	// poll interval if unset. A poll interval requested by the backend takes
+	// precedence.
+	// +optional
+	PendingPollBackoff *PollBackoff `json:"pendingPollBackoff,omitempty"`
+
+	// Protocol is the API style of the orders backend. With graphql, orders
+	// are created and observed through GraphQL operations sent to
+	// APIEndpoint.
+	// +kubebuilder:validation:Enum=rest;graphql
+	// +kubebuilder:default=rest
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+
+	// GraphQL configures the operations used with the graphql protocol.
+	// +optional
+	GraphQL *GraphQLParameters `json:"graphql,omitempty"`
+
+	// RequestSchema validates the create request body before it is sent, so
+	// that contract mismatches fail with the schema error rather than an
+	// opaque error response of the backend.
+	// +optional
+	RequestSchema *RequestSchema `json:"requestSchema,omitempty"`
+
+	// Maintenance configures a check of the backend maintenance status
+	// before the order is submitted. Orders are held back while maintenance
+	// is active.
+	// +optional
+	Maintenance *MaintenanceCheck `json:"maintenance,omitempty"`
+
+	// Quota configures a check of the remaining rule quota of the tenant
+	// before the order is submitted. Creation fails with the quota details,
+	// rather than a rejection of the backend, if the rules of the order
+	// exceed it.
+	// +optional
+	Quota *QuotaCheck `json:"quota,omitempty"`
+
+	// EventSink publishes the created, ready, failed and deleted events of
+	// the order. Delivery is best-effort and retried a few times in the
+	// background, so it never holds up the reconcile.
+	// +optional
+	EventSink *EventSink `json:"eventSink,omitempty"`
+
+	// Notification posts a message to a chat webhook once the order is ready
+	// or failed. Like events, notifications are best-effort and sent in the
+	// background.
+	// +optional
+	Notification *Notification `json:"notification,omitempty"`
+
+	// RolloutPercentage is the percentage of matching devices the order is
+	// applied to at first. Once the backend has applied it, the percentage is
+	// raised by RolloutStep every RolloutStepInterval until it reaches 100.
+	// The order is applied to all devices at once if unset.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	RolloutPercentage *int `json:"rolloutPercentage,omitempty"`
+
+	// RolloutStep is the percentage added at each rollout step. Defaults to
+	// 25.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	RolloutStep *int `json:"rolloutStep,omitempty"`
+
+	// RolloutStepInterval is the minimum time between rollout steps.
+	// Defaults to 10m.
+	// +optional
+	RolloutStepInterval *metav1.Duration `json:"rolloutStepInterval,omitempty"`
+
+	// DependsOn are the names of PortOrders that must succeed before this
+	// order is submitted.
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
+
+	// GroupID correlates the orders of an all-or-nothing change set. It is
+	// sent to the backend with the order, and no order of the group becomes
+	// ready before all of them have succeeded. The controller labels orders
+	// of a group with portorder.example.com/group set to the GroupID, by
+	// which the orders of a group are found.
+	// +optional
+	GroupID string `json:"groupId,omitempty"`
+
+	// MaxLifetimeSeconds is how long after the PortOrder was created the
+	// order expires. An expired order is cancelled at the backend and then
+	// handled according to ExpiryAction. Orders do not expire if unset.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxLifetimeSeconds *int64 `json:"maxLifetimeSeconds,omitempty"`
+
+	// ExpiryAction is what happens to the PortOrder once its order expired
+	// and was cancelled. With Delete, the PortOrder is deleted. With Cancel,
+	// it is kept with the Expired condition, and the order is not submitted
+	// again.
+	// +kubebuilder:validation:Enum=Delete;Cancel
+	// +kubebuilder:default=Delete
+	// +optional
+	ExpiryAction string `json:"expiryAction,omitempty"`
+}
+
+// FinalizationReport summarizes an order that reached a terminal status.
+type FinalizationReport struct {
+	// OrderID is the ID assigned by the API
+	OrderID string `json:"orderId"`
+
+	// Ports are the ports of the order, e.g. "tcp/443"
+	Ports []string `json:"ports,omitempty"`
+
+	// FinalStatus is the terminal status reported by the backend
+	FinalStatus string `json:"finalStatus"`
+
+	// CompletionTime is when the terminal status was first observed
+	CompletionTime metav1.Time `json:"completionTime"`
+
+	// DurationSeconds is the time from the creation of the PortOrder to its
+	// completion
+	DurationSeconds int64 `json:"durationSeconds"`
+}
+
+// OrderTransition is a status transition of an order, as reported by the
+// history endpoint of the backend.
+type OrderTransition struct {
+	// Status is the status the order transitioned to
+	Status string `json:"status"`
+
+	// Actor is who or what caused the transition, if reported
+	// +optional
+	Actor string `json:"actor,omitempty"`
+
+	// Time is when the transition happened, if reported
+	// +optional
+	Time *metav1.Time `json:"time,omitempty"`
 }
 
 // PortOrderObservation are the observable fields of a PortOrder.
@@ -66,14 +863,173 @@ type PortOrderObservation struct {
 	// OrderID is the ID assigned by the API
 	OrderID string `json:"orderId,omitempty"`
 
+	// RuleIDs are the IDs of the device rules the backend created for the
+	// order
+	// +optional
+	RuleIDs []string `json:"ruleIds,omitempty"`
+
+	// OrderIDs are the IDs of all rule objects the backend split the order
+	// into, when it returned more than one. OrderID is the first of them.
+	// +optional
+	OrderIDs []string `json:"orderIds,omitempty"`
+
 	// Status is the current status of the order
 	Status string `json:"status,omitempty"`
 
+	// ReadyAt is when the order was first observed ready. It is set once and
+	// kept afterwards, even if the order becomes unready again
+	// +optional
+	ReadyAt *metav1.Time `json:"readyAt,omitempty"`
+
+	// StatusSince is when the order entered its current status, as the
+	// backend reports it, or else when the status was first observed
+	StatusSince *metav1.Time `json:"statusSince,omitempty"`
+
+	// StatusAge is how long the order has been in its current status as of
+	// its last observation, e.g. "3h25m"
+	StatusAge string `json:"statusAge,omitempty"`
+
+	// PollsInStatus is the number of observations of the order since its
+	// status last changed
+	PollsInStatus int `json:"pollsInStatus,omitempty"`
+
 	// LastRequestTime is when the order was last submitted
 	LastRequestTime *metav1.Time `json:"lastRequestTime,omitempty"`
 
 	// LastResponseStatus is the HTTP status code of the last response
 	LastResponseStatus int `json:"lastResponseStatus,omitempty"`
+
+	// PollAfterSeconds is how long the backend asked to wait before the
+	// order is polled again
+	PollAfterSeconds *int64 `json:"pollAfterSeconds,omitempty"`
+
+	// RolloutPercentage is the rollout percentage last requested
+	RolloutPercentage *int `json:"rolloutPercentage,omitempty"`
+
+	// AppliedPercentage is the rollout percentage the backend has applied
+	AppliedPercentage *int `json:"appliedPercentage,omitempty"`
+
+	// LastRolloutStepTime is when the rollout percentage was last requested
+	LastRolloutStepTime *metav1.Time `json:"lastRolloutStepTime,omitempty"`
+
+	// AffectedDevices is the number of firewall devices the order touches
+	AffectedDevices int `json:"affectedDevices,omitempty"`
+
+	// Zone is the firewall zone the backend placed the order in. Rule
+	// objects placed in different zones are listed comma-separated
+	Zone string `json:"zone,omitempty"`
+
+	// CancelRequestTime is when the order was cancelled
+	CancelRequestTime *metav1.Time `json:"cancelRequestTime,omitempty"`
+
+	// ExpiredTime is when the order was cancelled because it exceeded its
+	// maximum lifetime
+	ExpiredTime *metav1.Time `json:"expiredTime,omitempty"`
+
+	// DeletionChecks is how often the backend was checked for the cancelled
+	// order
+	DeletionChecks int `json:"deletionChecks,omitempty"`
+
+	// Source is the source of the order as reported by the backend, or as
+	// submitted if the order is observed on create only
+	Source string `json:"source,omitempty"`
+
+	// Destination is the destination of the order as reported by the
+	// backend, or as submitted if the order is observed on create only
+	Destination string `json:"destination,omitempty"`
+
+	// ApprovalsPending is the number of approvals of the order that are not
+	// done yet
+	ApprovalsPending int `json:"approvalsPending,omitempty"`
+
+	// ApprovalsTotal is the number of approvals the order requires
+	ApprovalsTotal int `json:"approvalsTotal,omitempty"`
+
+	// History are the most recent status transitions of the order, oldest
+	// first, if its HistoryLimit is set and the backend supports them
+	// +optional
+	History []OrderTransition `json:"history,omitempty"`
+
+	// StatusReason is the human-readable reason the backend gives for the
+	// status of the order, e.g. why it was rejected
+	StatusReason string `json:"statusReason,omitempty"`
+
+	// NextAction is what the backend reports is needed to progress the
+	// order, e.g. "awaiting-approval-from-netsec"
+	NextAction string `json:"nextAction,omitempty"`
+
+	// Receipt is the signed receipt, base64-encoded, the backend returns as
+	// proof that it accepted the order. It is stored as it is returned,
+	// without being verified, and kept until the backend returns another.
+	// +optional
+	Receipt string `json:"receipt,omitempty"`
+
+	// BackendTags are the tags the backend reports for the order, including
+	// those it assigned itself, e.g. by classifying the order
+	// +optional
+	BackendTags map[string]string `json:"backendTags,omitempty"`
+
+	// LastModifiedByBackend is when the backend last changed the order, as
+	// it reports it. Unlike LastRequestTime, it also reflects changes the
+	// backend made on its own, e.g. expiry adjustments
+	LastModifiedByBackend *metav1.Time `json:"lastModifiedByBackend,omitempty"`
+
+	// RedirectedURL is the URL the orders API last redirected a request of
+	// the order to, without its query
+	RedirectedURL string `json:"redirectedURL,omitempty"`
+
+	// SunsetTime is when the backend retires the endpoint the order was last
+	// requested at, as announced in the Sunset header of its responses
+	// +optional
+	SunsetTime *metav1.Time `json:"sunsetTime,omitempty"`
+
+	// Versions are the revisions of the rule objects of the order last
+	// reported by the backend, keyed by order ID. Modifications are sent
+	// with them as If-Match
+	Versions map[string]string `json:"versions,omitempty"`
+
+	// ObserveCursor is the cursor the backend returned with the last read of
+	// the order. The next read asks only for what changed since
+	ObserveCursor string `json:"observeCursor,omitempty"`
+
+	// ReadinessProbeSucceeded is whether the readiness endpoint of the order
+	// reported it as ready when it was last probed
+	ReadinessProbeSucceeded bool `json:"readinessProbeSucceeded,omitempty"`
+
+	// RejectedGeneration is the generation of the PortOrder whose create the
+	// backend rejected with a terminal status code, whose queued job was
+	// rejected or failed, or that was not submitted because no retry could
+	// succeed, such as when it exceeds the tenant rule quota. The order is not
+	// submitted again until its generation changes
+	RejectedGeneration int64 `json:"rejectedGeneration,omitempty"`
+
+	// ForceResync is the last value of the force-resync annotation the
+	// order was submitted again for
+	ForceResync string `json:"forceResync,omitempty"`
+
+	// Ticket identifies the job the order was queued as, if the backend
+	// queues orders
+	Ticket string `json:"ticket,omitempty"`
+
+	// QueueState is the last state of the job the order was queued as
+	QueueState string `json:"queueState,omitempty"`
+
+	// CreateProgress are the progress lines the backend streamed while the
+	// order was created, up to the last 20 of them
+	// +optional
+	CreateProgress []string `json:"createProgress,omitempty"`
+
+	// ObservedPorts are the ports the backend reports for the order, e.g.
+	// "tcp/443", or "tcp/8000-8010" for a range, if it reports them
+	ObservedPorts []string `json:"observedPorts,omitempty"`
+
+	// QuotaRemaining is the number of rules the tenant could still create
+	// when the quota was last checked
+	QuotaRemaining *int64 `json:"quotaRemaining,omitempty"`
+
+	// FinalizationReport is written once, when the order first reaches a
+	// ready or failed status
+	FinalizationReport *FinalizationReport `json:"finalizationReport,omitempty"`
 }
 
 // A PortOrderSpec defines the desired state of a PortOrder.
@@ -96,6 +1052,9 @@ type PortOrderStatus struct {
 // +kubebuilder:printcolumn:name="SOURCE",type="string",JSONPath=".spec.forProvider.source"
 // +kubebuilder:printcolumn:name="DESTINATION",type="string",JSONPath=".spec.forProvider.destination"
 // +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="IN-STATUS",type="string",JSONPath=".status.atProvider.statusAge"
+// +kubebuilder:printcolumn:name="DEVICES",type="integer",JSONPath=".status.atProvider.affectedDevices"
+// +kubebuilder:printcolumn:name="ZONE",type="string",JSONPath=".status.atProvider.zone"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,network}
@@ -127,94 +1086,3 @@ var (
 func init() {
 	SchemeBuilder.Register(&PortOrder{}, &PortOrderList{})
 }
-
-// -----------------------------------------------------------------------------
-// Manual DeepCopy implementations
-// -----------------------------------------------------------------------------
-
-// DeepCopyInto for PortOrder copies the receiver into out.
-func (in *PortOrder) DeepCopyInto(out *PortOrder) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
-}
-
-// DeepCopy for PortOrder creates a new deep copy.
-func (in *PortOrder) DeepCopy() *PortOrder {
-	if in == nil {
-		return nil
-	}
-	out := new(PortOrder)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject makes PortOrder implement runtime.Object.
-func (in *PortOrder) DeepCopyObject() runtime.Object {
-	return in.DeepCopy()
-}
-
-// -----------------------------------------------------------------------------
-// PortOrderSpec DeepCopy
-// -----------------------------------------------------------------------------
-
-func (in *PortOrderSpec) DeepCopyInto(out *PortOrderSpec) {
-	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	if in.ForProvider.Ports != nil {
-		out.ForProvider.Ports = make([]PortParameters, len(in.ForProvider.Ports))
-		copy(out.ForProvider.Ports, in.ForProvider.Ports)
-	}
-}
-
-// -----------------------------------------------------------------------------
-// PortOrderStatus DeepCopy
-// -----------------------------------------------------------------------------
-
-func (in *PortOrderStatus) DeepCopyInto(out *PortOrderStatus) {
-	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
-}
-
-// -----------------------------------------------------------------------------
-// PortOrderObservation DeepCopy
-// -----------------------------------------------------------------------------
-
-func (in *PortOrderObservation) DeepCopyInto(out *PortOrderObservation) {
-	*out = *in
-	if in.LastRequestTime != nil {
-		out.LastRequestTime = in.LastRequestTime.DeepCopy()
-	}
-}
-
-// -----------------------------------------------------------------------------
-// PortOrderList DeepCopy
-// -----------------------------------------------------------------------------
-
-func (in *PortOrderList) DeepCopyInto(out *PortOrderList) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		out.Items = make([]PortOrder, len(in.Items))
-		for i := range in.Items {
-			in.Items[i].DeepCopyInto(&out.Items[i])
-		}
-	}
-}
-
-func (in *PortOrderList) DeepCopy() *PortOrderList {
-	if in == nil {
-		return nil
-	}
-	out := new(PortOrderList)
-	in.DeepCopyInto(out)
-	return out
-}
-
-func (in *PortOrderList) DeepCopyObject() runtime.Object {
-	return in.DeepCopy()
-}
@@ -0,0 +1,54 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidatePorts checks the ports of the order against the rules of their
+// protocol: tcp and udp ports need a port number, which may only be 0 if
+// AllowAnyPort is set, while icmp ports must not have one. The ports are
+// checked at admission by the validating webhook, if it is enabled, and by the
+// controller before it creates the order.
+func (p *PortOrderParameters) ValidatePorts() field.ErrorList {
+	var errs field.ErrorList
+	invalidNumber := "must be between 1 and 65535"
+	if p.AllowAnyPort {
+		invalidNumber = "must be between 0 and 65535"
+	}
+	path := field.NewPath("spec", "forProvider", "ports")
+	for i, port := range p.Ports {
+		number := path.Index(i).Child("number")
+		switch port.Type {
+		case "", PortTypeTCP, PortTypeUDP:
+			switch {
+			case port.Number < 0 || port.Number > 65535:
+				errs = append(errs, field.Invalid(number, port.Number, invalidNumber))
+			case port.Number == 0 && !p.AllowAnyPort:
+				errs = append(errs, field.Required(number, "tcp and udp ports need a port number, unless allowAnyPort is set"))
+			}
+		case PortTypeICMP:
+			if port.Number != 0 {
+				errs = append(errs, field.Invalid(number, port.Number, "icmp ports must not have a port number"))
+			}
+		default:
+			errs = append(errs, field.NotSupported(path.Index(i).Child("type"), port.Type, []string{PortTypeTCP, PortTypeUDP, PortTypeICMP}))
+		}
+	}
+	return errs
+}
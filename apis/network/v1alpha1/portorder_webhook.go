@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-network-http-crossplane-io-v1alpha1-portorder,mutating=false,failurePolicy=fail,groups=network.http.crossplane.io,resources=portorders,versions=v1alpha1,name=portorders.network.http.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// SetupWebhookWithManager registers the webhook that validates PortOrders at
+// admission with the webhook server of the manager.
+func SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&PortOrder{}).
+		WithValidator(&portOrderValidator{}).
+		Complete()
+}
+
+// portOrderValidator rejects PortOrders whose ports break the rules of their
+// protocol.
+type portOrderValidator struct{}
+
+// ValidateCreate validates the ports of a created PortOrder.
+func (v *portOrderValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	po, err := asPortOrder(obj)
+	if err != nil {
+		return nil, err
+	}
+	return nil, validatePorts(po)
+}
+
+// ValidateUpdate validates the ports of an updated PortOrder if they changed,
+// so that PortOrders created before the webhook can still be updated, e.g.
+// to remove their finalizer.
+func (v *portOrderValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, err := asPortOrder(oldObj)
+	if err != nil {
+		return nil, err
+	}
+	po, err := asPortOrder(newObj)
+	if err != nil {
+		return nil, err
+	}
+	if reflect.DeepEqual(old.Spec.ForProvider.Ports, po.Spec.ForProvider.Ports) &&
+		old.Spec.ForProvider.AllowAnyPort == po.Spec.ForProvider.AllowAnyPort {
+		return nil, nil
+	}
+	return nil, validatePorts(po)
+}
+
+// ValidateDelete allows every PortOrder to be deleted.
+func (v *portOrderValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func asPortOrder(obj runtime.Object) (*PortOrder, error) {
+	po, ok := obj.(*PortOrder)
+	if !ok {
+		return nil, fmt.Errorf("expected a PortOrder, got %T", obj)
+	}
+	return po, nil
+}
+
+func validatePorts(po *PortOrder) error {
+	if errs := po.Spec.ForProvider.ValidatePorts(); len(errs) > 0 {
+		return kerrors.NewInvalid(PortOrderGroupVersionKind.GroupKind(), po.GetName(), errs)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func portOrderWith(ports ...PortParameters) *PortOrder {
+	po := &PortOrder{}
+	po.SetName("order")
+	po.Spec.ForProvider.Ports = ports
+	return po
+}
+
+func Test_portOrderValidator(t *testing.T) {
+	icmpWithNumber := PortParameters{Type: PortTypeICMP, Number: 8}
+	errICMP := kerrors.NewInvalid(PortOrderGroupVersionKind.GroupKind(), "order", field.ErrorList{
+		field.Invalid(field.NewPath("spec", "forProvider", "ports").Index(0).Child("number"), 8, "icmp ports must not have a port number"),
+	})
+
+	cases := map[string]struct {
+		old  *PortOrder
+		new  *PortOrder
+		want error
+	}{
+		"CreateValid": {
+			new: portOrderWith(PortParameters{Type: PortTypeTCP, Number: 443}),
+		},
+		"CreateInvalid": {
+			new:  portOrderWith(icmpWithNumber),
+			want: errICMP,
+		},
+		"UpdateChangedPorts": {
+			old:  portOrderWith(PortParameters{Type: PortTypeTCP, Number: 443}),
+			new:  portOrderWith(icmpWithNumber),
+			want: errICMP,
+		},
+		"UpdateUnchangedPorts": {
+			old: portOrderWith(icmpWithNumber),
+			new: portOrderWith(icmpWithNumber),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			v := &portOrderValidator{}
+			var err error
+			if tc.old == nil {
+				_, err = v.ValidateCreate(context.Background(), tc.new)
+			} else {
+				_, err = v.ValidateUpdate(context.Background(), tc.old, tc.new)
+			}
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("validate(...): -want error, +got error: %s", diff)
+			}
+		})
+	}
+}
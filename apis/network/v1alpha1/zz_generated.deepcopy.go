@@ -0,0 +1,705 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	commonv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySelector.
+func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeleteConfirmation) DeepCopyInto(out *DeleteConfirmation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteConfirmation.
+func (in *DeleteConfirmation) DeepCopy() *DeleteConfirmation {
+	if in == nil {
+		return nil
+	}
+	out := new(DeleteConfirmation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSink) DeepCopyInto(out *EventSink) {
+	*out = *in
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSink.
+func (in *EventSink) DeepCopy() *EventSink {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FinalizationReport) DeepCopyInto(out *FinalizationReport) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.CompletionTime.DeepCopyInto(&out.CompletionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FinalizationReport.
+func (in *FinalizationReport) DeepCopy() *FinalizationReport {
+	if in == nil {
+		return nil
+	}
+	out := new(FinalizationReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GraphQLParameters) DeepCopyInto(out *GraphQLParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GraphQLParameters.
+func (in *GraphQLParameters) DeepCopy() *GraphQLParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GraphQLParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceCheck) DeepCopyInto(out *MaintenanceCheck) {
+	*out = *in
+	if in.ActiveField != nil {
+		in, out := &in.ActiveField, &out.ActiveField
+		*out = new(ResponseFieldMatch)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceCheck.
+func (in *MaintenanceCheck) DeepCopy() *MaintenanceCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Notification) DeepCopyInto(out *Notification) {
+	*out = *in
+	out.WebhookURLSecretRef = in.WebhookURLSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Notification.
+func (in *Notification) DeepCopy() *Notification {
+	if in == nil {
+		return nil
+	}
+	out := new(Notification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrderQueue) DeepCopyInto(out *OrderQueue) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrderQueue.
+func (in *OrderQueue) DeepCopy() *OrderQueue {
+	if in == nil {
+		return nil
+	}
+	out := new(OrderQueue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrderTransition) DeepCopyInto(out *OrderTransition) {
+	*out = *in
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrderTransition.
+func (in *OrderTransition) DeepCopy() *OrderTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(OrderTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PollBackoff) DeepCopyInto(out *PollBackoff) {
+	*out = *in
+	if in.Base != nil {
+		in, out := &in.Base, &out.Base
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Factor != nil {
+		in, out := &in.Factor, &out.Factor
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Cap != nil {
+		in, out := &in.Cap, &out.Cap
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PollBackoff.
+func (in *PollBackoff) DeepCopy() *PollBackoff {
+	if in == nil {
+		return nil
+	}
+	out := new(PollBackoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortOrder) DeepCopyInto(out *PortOrder) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortOrder.
+func (in *PortOrder) DeepCopy() *PortOrder {
+	if in == nil {
+		return nil
+	}
+	out := new(PortOrder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PortOrder) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortOrderList) DeepCopyInto(out *PortOrderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PortOrder, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortOrderList.
+func (in *PortOrderList) DeepCopy() *PortOrderList {
+	if in == nil {
+		return nil
+	}
+	out := new(PortOrderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PortOrderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortOrderObservation) DeepCopyInto(out *PortOrderObservation) {
+	*out = *in
+	if in.RuleIDs != nil {
+		in, out := &in.RuleIDs, &out.RuleIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OrderIDs != nil {
+		in, out := &in.OrderIDs, &out.OrderIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReadyAt != nil {
+		in, out := &in.ReadyAt, &out.ReadyAt
+		*out = (*in).DeepCopy()
+	}
+	if in.StatusSince != nil {
+		in, out := &in.StatusSince, &out.StatusSince
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRequestTime != nil {
+		in, out := &in.LastRequestTime, &out.LastRequestTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PollAfterSeconds != nil {
+		in, out := &in.PollAfterSeconds, &out.PollAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RolloutPercentage != nil {
+		in, out := &in.RolloutPercentage, &out.RolloutPercentage
+		*out = new(int)
+		**out = **in
+	}
+	if in.AppliedPercentage != nil {
+		in, out := &in.AppliedPercentage, &out.AppliedPercentage
+		*out = new(int)
+		**out = **in
+	}
+	if in.LastRolloutStepTime != nil {
+		in, out := &in.LastRolloutStepTime, &out.LastRolloutStepTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CancelRequestTime != nil {
+		in, out := &in.CancelRequestTime, &out.CancelRequestTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiredTime != nil {
+		in, out := &in.ExpiredTime, &out.ExpiredTime
+		*out = (*in).DeepCopy()
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]OrderTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BackendTags != nil {
+		in, out := &in.BackendTags, &out.BackendTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LastModifiedByBackend != nil {
+		in, out := &in.LastModifiedByBackend, &out.LastModifiedByBackend
+		*out = (*in).DeepCopy()
+	}
+	if in.SunsetTime != nil {
+		in, out := &in.SunsetTime, &out.SunsetTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CreateProgress != nil {
+		in, out := &in.CreateProgress, &out.CreateProgress
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ObservedPorts != nil {
+		in, out := &in.ObservedPorts, &out.ObservedPorts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.QuotaRemaining != nil {
+		in, out := &in.QuotaRemaining, &out.QuotaRemaining
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FinalizationReport != nil {
+		in, out := &in.FinalizationReport, &out.FinalizationReport
+		*out = new(FinalizationReport)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortOrderObservation.
+func (in *PortOrderObservation) DeepCopy() *PortOrderObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PortOrderObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortOrderParameters) DeepCopyInto(out *PortOrderParameters) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]PortParameters, len(*in))
+		copy(*out, *in)
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
+	if in.JustificationSecretRef != nil {
+		in, out := &in.JustificationSecretRef, &out.JustificationSecretRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
+	if in.MaxResponseBytes != nil {
+		in, out := &in.MaxResponseBytes, &out.MaxResponseBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxRequestBytes != nil {
+		in, out := &in.MaxRequestBytes, &out.MaxRequestBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxReceiptBytes != nil {
+		in, out := &in.MaxReceiptBytes, &out.MaxReceiptBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SuccessField != nil {
+		in, out := &in.SuccessField, &out.SuccessField
+		*out = new(ResponseFieldMatch)
+		**out = **in
+	}
+	if in.RetryOnBodyMatch != nil {
+		in, out := &in.RetryOnBodyMatch, &out.RetryOnBodyMatch
+		*out = new(ResponseFieldMatch)
+		**out = **in
+	}
+	if in.TimeoutEscalation != nil {
+		in, out := &in.TimeoutEscalation, &out.TimeoutEscalation
+		*out = new(TimeoutEscalation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NotFoundGracePeriod != nil {
+		in, out := &in.NotFoundGracePeriod, &out.NotFoundGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DeleteConfirmation != nil {
+		in, out := &in.DeleteConfirmation, &out.DeleteConfirmation
+		*out = new(DeleteConfirmation)
+		**out = **in
+	}
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = new(OrderQueue)
+		**out = **in
+	}
+	if in.FollowRedirects != nil {
+		in, out := &in.FollowRedirects, &out.FollowRedirects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StatusCodeActions != nil {
+		in, out := &in.StatusCodeActions, &out.StatusCodeActions
+		*out = make([]StatusCodeAction, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxDeletionChecks != nil {
+		in, out := &in.MaxDeletionChecks, &out.MaxDeletionChecks
+		*out = new(int)
+		**out = **in
+	}
+	if in.ReadyStatuses != nil {
+		in, out := &in.ReadyStatuses, &out.ReadyStatuses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HistoryLimit != nil {
+		in, out := &in.HistoryLimit, &out.HistoryLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FailedStatuses != nil {
+		in, out := &in.FailedStatuses, &out.FailedStatuses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailedPollInterval != nil {
+		in, out := &in.FailedPollInterval, &out.FailedPollInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.PendingPollBackoff != nil {
+		in, out := &in.PendingPollBackoff, &out.PendingPollBackoff
+		*out = new(PollBackoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GraphQL != nil {
+		in, out := &in.GraphQL, &out.GraphQL
+		*out = new(GraphQLParameters)
+		**out = **in
+	}
+	if in.RequestSchema != nil {
+		in, out := &in.RequestSchema, &out.RequestSchema
+		*out = new(RequestSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(QuotaCheck)
+		**out = **in
+	}
+	if in.EventSink != nil {
+		in, out := &in.EventSink, &out.EventSink
+		*out = new(EventSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Notification != nil {
+		in, out := &in.Notification, &out.Notification
+		*out = new(Notification)
+		**out = **in
+	}
+	if in.RolloutPercentage != nil {
+		in, out := &in.RolloutPercentage, &out.RolloutPercentage
+		*out = new(int)
+		**out = **in
+	}
+	if in.RolloutStep != nil {
+		in, out := &in.RolloutStep, &out.RolloutStep
+		*out = new(int)
+		**out = **in
+	}
+	if in.RolloutStepInterval != nil {
+		in, out := &in.RolloutStepInterval, &out.RolloutStepInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxLifetimeSeconds != nil {
+		in, out := &in.MaxLifetimeSeconds, &out.MaxLifetimeSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortOrderParameters.
+func (in *PortOrderParameters) DeepCopy() *PortOrderParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PortOrderParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortOrderSpec) DeepCopyInto(out *PortOrderSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortOrderSpec.
+func (in *PortOrderSpec) DeepCopy() *PortOrderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PortOrderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortOrderStatus) DeepCopyInto(out *PortOrderStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortOrderStatus.
+func (in *PortOrderStatus) DeepCopy() *PortOrderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PortOrderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortParameters) DeepCopyInto(out *PortParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortParameters.
+func (in *PortParameters) DeepCopy() *PortParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PortParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaCheck) DeepCopyInto(out *QuotaCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaCheck.
+func (in *QuotaCheck) DeepCopy() *QuotaCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestSchema) DeepCopyInto(out *RequestSchema) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestSchema.
+func (in *RequestSchema) DeepCopy() *RequestSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseFieldMatch) DeepCopyInto(out *ResponseFieldMatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseFieldMatch.
+func (in *ResponseFieldMatch) DeepCopy() *ResponseFieldMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseFieldMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatusCodeAction) DeepCopyInto(out *StatusCodeAction) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusCodeAction.
+func (in *StatusCodeAction) DeepCopy() *StatusCodeAction {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusCodeAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeoutEscalation) DeepCopyInto(out *TimeoutEscalation) {
+	*out = *in
+	out.Timeout = in.Timeout
+	if in.FactorPercent != nil {
+		in, out := &in.FactorPercent, &out.FactorPercent
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxTimeout != nil {
+		in, out := &in.MaxTimeout, &out.MaxTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxAttempts != nil {
+		in, out := &in.MaxAttempts, &out.MaxAttempts
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeoutEscalation.
+func (in *TimeoutEscalation) DeepCopy() *TimeoutEscalation {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeoutEscalation)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -19,6 +19,7 @@ package main
 import (
 	"os"
 	"path/filepath"
+	"regexp"
 	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -26,15 +27,20 @@ import (
 	"gopkg.in/alecthomas/kingpin.v2"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/feature"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 
 	"github.com/crossplane-contrib/provider-http/apis"
+	networkv1alpha1 "github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
 	template "github.com/crossplane-contrib/provider-http/internal/controller"
+	"github.com/crossplane-contrib/provider-http/internal/controller/network"
 )
 
 func main() {
@@ -46,6 +52,27 @@ func main() {
 		syncInterval     = app.Flag("sync", "How often all resources will be double-checked for drift from the desired state.").Short('s').Default("1h").Duration()
 		pollInterval     = app.Flag("poll", "How often individual resources will be checked for drift from the desired state").Default("1m").Duration()
 		maxReconcileRate = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may checked for drift from the desired state.").Default("10").Int()
+		healthProbeAddr  = app.Flag("health-probe-bind-address", "Address the /healthz and /readyz endpoints are served at. Disabled when 0.").Default(":8081").String()
+		enableWebhooks   = app.Flag("enable-webhooks", "Serve the webhook that validates PortOrders at admission. Must be enabled while its webhook configuration is installed, as it is by the package.").Default("true").Bool()
+		certsDir         = app.Flag("certs-dir", "Directory holding the tls.crt and tls.key of the webhook server.").Default("/tls/server").Envar("TLS_SERVER_CERTS_DIR").String()
+
+		portOrderFinalizer = app.Flag("port-order-finalizer", "Finalizer added to PortOrders. PortOrders holding the default finalizer are released when deleted.").Default(managed.FinalizerName).String()
+		legacyFinalizers   = app.Flag("port-order-legacy-finalizer", "Finalizer PortOrders were previously created with, removed along with the current one when they are deleted. May be repeated.").Strings()
+		multiTenant        = app.Flag("multi-tenant", "Reject PortOrders that do not set a tenantId.").Default("false").Bool()
+		tenantIDPattern    = app.Flag("tenant-id-pattern", "Regular expression PortOrder tenantIds must match. Defaults to a UUID.").Default("").String()
+		observeCacheTTL    = app.Flag("port-order-observe-cache-ttl", "How long a PortOrder read from the backend is reused by later observations. Disabled when zero.").Default("0s").Duration()
+		observeOnResync    = app.Flag("port-order-observe-on-resync", "Observe every PortOrder at each sync interval, even if its desired state did not change.").Default("false").Bool()
+		redactPatterns     = app.Flag("port-order-redact-pattern", "Regular expression matching sensitive text of backend error messages, which is redacted from PortOrder status and events. Only the subexpression named secret is redacted, if any. May be repeated; common tokens and secrets are always redacted.").Strings()
+		createDedupWindow  = app.Flag("port-order-create-dedup-window", "How long after a PortOrder was created a repeated create with an unchanged spec is suppressed. Disabled when zero.").Default("0s").Duration()
+		latencyWindow      = app.Flag("port-order-latency-window", "How long orders API response times are kept to export their p50, p95 and p99 per endpoint. Disabled when zero.").Default("5m").Duration()
+		reservedPorts      = app.Flag("port-order-reserved-ports", "Port or inclusive range of ports, e.g. 0-1023, that PortOrders may not open unless annotated with portorder.example.com/privileged=true. May be repeated.").Strings()
+		connectBackoff     = app.Flag("port-order-connect-backoff", "Delay after which a PortOrder that failed to connect to the backend, e.g. due to missing credentials, is retried. Doubles with every failure in a row until connecting succeeds. Disabled when zero.").Default("10s").Duration()
+		connectBackoffMax  = app.Flag("port-order-connect-backoff-max", "Maximum delay after which a PortOrder that failed to connect to the backend is retried. Unlimited when zero.").Default("10m").Duration()
+		priorityDeferral   = app.Flag("port-order-priority-deferral", "Delay after which a PortOrder that yielded to a PortOrder of a higher priority that is being created is reconciled again. Reconciles are not ordered by priority when zero.").Default("0s").Duration()
+		maxDeferrals       = app.Flag("port-order-priority-max-deferrals", "Maximum number of times in a row a PortOrder yields to pending PortOrders of a higher priority. Reconciles are not ordered by priority when zero.").Default("5").Int()
+		healthURL          = app.Flag("port-order-health-url", "Health endpoint of the orders backend, probed periodically. The controller is only ready while the last probe reached the backend. Disabled when empty.").Default("").String()
+		healthInterval     = app.Flag("port-order-health-interval", "How often the health endpoint of the orders backend is probed.").Default("30s").Duration()
+		maxWrites          = app.Flag("port-order-max-concurrent-writes", "Maximum number of PortOrder create, update and cancel requests sent to the backend at once. Unlimited when zero.").Default("0").Int()
 
 		// namespace = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
 	)
@@ -74,8 +101,14 @@ func main() {
 		LeaderElectionResourceLock: resourcelock.LeasesResourceLock,
 		LeaseDuration:              func() *time.Duration { d := 60 * time.Second; return &d }(),
 		RenewDeadline:              func() *time.Duration { d := 50 * time.Second; return &d }(),
+		HealthProbeBindAddress:     *healthProbeAddr,
+		WebhookServer: webhook.NewServer(webhook.Options{
+			CertDir: *certsDir,
+		}),
 	})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
+	kingpin.FatalIfError(mgr.AddHealthzCheck("ping", healthz.Ping), "Cannot add health check")
+	kingpin.FatalIfError(mgr.AddReadyzCheck("ping", healthz.Ping), "Cannot add readiness check")
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add Http APIs to scheme")
 
 	o := controller.Options{
@@ -86,6 +119,40 @@ func main() {
 		Features:                &feature.Flags{},
 	}
 
-	kingpin.FatalIfError(template.Setup(mgr, o, *timeout), "Cannot setup Template controllers")
+	no := network.Options{
+		FinalizerName:        *portOrderFinalizer,
+		LegacyFinalizerNames: *legacyFinalizers,
+		MultiTenant:          *multiTenant,
+		ObserveCacheTTL:      *observeCacheTTL,
+		MaxConcurrentWrites:  *maxWrites,
+		ObserveOnResync:      *observeOnResync,
+		CreateDedupWindow:    *createDedupWindow,
+		LatencyWindow:        *latencyWindow,
+		ConnectBackoff:       *connectBackoff,
+		ConnectBackoffMax:    *connectBackoffMax,
+		PriorityDeferral:     *priorityDeferral,
+		PriorityMaxDeferrals: *maxDeferrals,
+		HealthURL:            *healthURL,
+		HealthInterval:       *healthInterval,
+	}
+	for _, p := range *redactPatterns {
+		re, err := regexp.Compile(p)
+		kingpin.FatalIfError(err, "Cannot compile redaction pattern")
+		no.RedactPatterns = append(no.RedactPatterns, re)
+	}
+	for _, p := range *reservedPorts {
+		r, err := network.ParsePortRange(p)
+		kingpin.FatalIfError(err, "Cannot parse reserved port range")
+		no.ReservedPorts = append(no.ReservedPorts, r)
+	}
+	if *tenantIDPattern != "" {
+		no.TenantIDPattern, err = regexp.Compile(*tenantIDPattern)
+		kingpin.FatalIfError(err, "Cannot compile tenant ID pattern")
+	}
+
+	kingpin.FatalIfError(template.Setup(mgr, o, *timeout, no), "Cannot setup Template controllers")
+	if *enableWebhooks {
+		kingpin.FatalIfError(networkv1alpha1.SetupWebhookWithManager(mgr), "Cannot setup PortOrder webhook")
+	}
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
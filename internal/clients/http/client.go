@@ -11,10 +11,13 @@ import (
 	"time"
 
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/pkg/errors"
 )
 
 const (
 	authKey = "Authorization"
+
+	errResponseTooLarge = "response body exceeds the maximum size of %d bytes"
 )
 
 // Client is the interface to interact with Http
@@ -22,16 +25,93 @@ type Client interface {
 	SendRequest(ctx context.Context, method string, url string, body Data, headers Data, skipTLSVerify bool) (resp HttpDetails, err error)
 }
 
+// Streamer sends requests whose responses are read as they arrive, such as
+// event streams, or that open connections the client does not send over,
+// such as WebSockets, with the same options as the requests of the client.
+type Streamer interface {
+	// Stream sends the request like SendRequest, but without the client
+	// timeout, and returns the response with its body unread. The caller
+	// closes the body.
+	Stream(ctx context.Context, method string, url string, headers Data, skipTLSVerify bool) (*http.Response, error)
+	// ConnectionConfig returns the headers and TLS configuration a request
+	// with the supplied headers is sent with.
+	ConnectionConfig(headers Data, skipTLSVerify bool) (http.Header, *tls.Config)
+}
+
 type client struct {
 	log                logging.Logger
 	timeout            time.Duration
 	authorizationToken string
+	maxResponseBytes   int64
+	// exactCaseHeaders maps canonical header keys to the casing they are
+	// sent with.
+	exactCaseHeaders map[string]string
+	// noRedirects returns redirect responses rather than following them.
+	noRedirects bool
+	// transports are kept for the lifetime of the client, so that
+	// connections are reused across requests. They are indexed by whether
+	// they skip TLS verification.
+	transports [2]*http.Transport
+}
+
+// ClientOption configures optional behaviour of the Http client.
+type ClientOption func(*client)
+
+// WithMaxResponseBytes limits the number of bytes read from a response body.
+// Responses larger than the limit are rejected with an error. A limit of zero
+// or less disables the check.
+func WithMaxResponseBytes(limit int64) ClientOption {
+	return func(c *client) {
+		c.maxResponseBytes = limit
+	}
+}
+
+// WithExactHeaderCasing sends the named headers with exactly the supplied
+// casing instead of the canonical form, for backends that match header names
+// case-sensitively. Only applies to HTTP/1.x, as HTTP/2 lowercases all
+// header names.
+func WithExactHeaderCasing(names ...string) ClientOption {
+	return func(c *client) {
+		if c.exactCaseHeaders == nil {
+			c.exactCaseHeaders = make(map[string]string, len(names))
+		}
+		for _, n := range names {
+			c.exactCaseHeaders[http.CanonicalHeaderKey(n)] = n
+		}
+	}
+}
+
+// WithTLSServerName verifies the certificate of the server against the
+// supplied name, and sends it as the TLS server name (SNI), rather than the
+// host of the request URL. This allows connecting to a server by its IP
+// address. An empty name keeps the host of the request URL.
+func WithTLSServerName(name string) ClientOption {
+	return func(c *client) {
+		if name == "" {
+			return
+		}
+		for _, t := range c.transports {
+			t.TLSClientConfig.ServerName = name
+		}
+	}
+}
+
+// WithFollowRedirects controls whether redirects are followed. If they are
+// not, the redirect response itself is returned. Redirects are followed by
+// default.
+func WithFollowRedirects(follow bool) ClientOption {
+	return func(c *client) {
+		c.noRedirects = !follow
+	}
 }
 
 type HttpResponse struct {
 	Body       string              `json:"body"`
 	Headers    map[string][]string `json:"headers"`
 	StatusCode int                 `json:"statusCode"`
+	// RedirectedURL is the URL the response was received from, if the
+	// request was redirected there.
+	RedirectedURL string `json:"-"`
 }
 
 type Data struct {
@@ -52,6 +132,8 @@ type HttpDetails struct {
 }
 
 // SendRequest sends an HTTP request to the specified URL with the given method, body, headers and skipTLSVerify.
+// The request is bound to ctx, so it is abandoned when ctx is cancelled or its deadline passes before the
+// client timeout does.
 func (hc *client) SendRequest(ctx context.Context, method string, url string, body Data, headers Data, skipTLSVerify bool) (details HttpDetails, err error) {
 	requestBody := []byte(body.Decrypted.(string))
 
@@ -72,24 +154,16 @@ func (hc *client) SendRequest(ctx context.Context, method string, url string, bo
 		}, err
 	}
 
-	for key, values := range headers.Decrypted.(map[string][]string) {
-		for _, value := range values {
-			request.Header.Add(key, value)
-		}
-	}
-
-	// Add the authorization token to the request if it doesn't already exist.
-	if _, exists := request.Header[authKey]; !exists && hc.authorizationToken != "" {
-		request.Header[authKey] = []string{hc.authorizationToken}
-	}
+	hc.setHeaders(request.Header, headers)
 
 	client := &http.Client{
-		Transport: &http.Transport{
-			// #nosec G402
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: skipTLSVerify},
-			Proxy:           http.ProxyFromEnvironment, // Use proxy settings from environment
-		},
-		Timeout: hc.timeout,
+		Transport: hc.transport(skipTLSVerify),
+		Timeout:   hc.timeout,
+	}
+	if hc.noRedirects {
+		client.CheckRedirect = func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
 	}
 
 	response, err := client.Do(request)
@@ -99,8 +173,9 @@ func (hc *client) SendRequest(ctx context.Context, method string, url string, bo
 		}, err
 	}
 
-	responsebody, err := io.ReadAll(response.Body)
+	responsebody, err := hc.readBody(response.Body)
 	if err != nil {
+		response.Body.Close() //nolint:errcheck // the read error takes precedence.
 		return HttpDetails{
 			HttpRequest: requestDetails,
 		}, err
@@ -111,6 +186,9 @@ func (hc *client) SendRequest(ctx context.Context, method string, url string, bo
 		Headers:    response.Header,
 		StatusCode: response.StatusCode,
 	}
+	if final := response.Request.URL; final.String() != request.URL.String() {
+		beautifiedResponse.RedirectedURL = final.String()
+	}
 
 	err = response.Body.Close()
 	if err != nil {
@@ -127,13 +205,106 @@ func (hc *client) SendRequest(ctx context.Context, method string, url string, bo
 	}, nil
 }
 
+// Stream sends the request without the client timeout and returns the
+// response with its body unread.
+func (hc *client) Stream(ctx context.Context, method string, url string, headers Data, skipTLSVerify bool) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	hc.setHeaders(request.Header, headers)
+
+	client := &http.Client{Transport: hc.transport(skipTLSVerify)}
+	if hc.noRedirects {
+		client.CheckRedirect = func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	}
+	return client.Do(request)
+}
+
+// ConnectionConfig returns the headers and TLS configuration requests with
+// the supplied headers are sent with.
+func (hc *client) ConnectionConfig(headers Data, skipTLSVerify bool) (http.Header, *tls.Config) {
+	h := http.Header{}
+	hc.setHeaders(h, headers)
+	return h, hc.transport(skipTLSVerify).TLSClientConfig.Clone()
+}
+
+// setHeaders adds the headers to be sent to the request headers, with the
+// configured casing and the authorization token unless they carry one.
+func (hc *client) setHeaders(h http.Header, headers Data) {
+	for key, values := range headers.Decrypted.(map[string][]string) {
+		// Setting the header map directly bypasses canonicalization.
+		if exact, ok := hc.exactCaseHeaders[http.CanonicalHeaderKey(key)]; ok {
+			h[exact] = append(h[exact], values...)
+			continue
+		}
+		for _, value := range values {
+			h.Add(key, value)
+		}
+	}
+
+	// Add the authorization token to the request if it doesn't already exist.
+	if _, exists := h[authKey]; !exists && hc.authorizationToken != "" {
+		h[authKey] = []string{hc.authorizationToken}
+	}
+}
+
+// transport returns the transport of requests with the supplied TLS
+// verification setting.
+func (hc *client) transport(skipTLSVerify bool) *http.Transport {
+	if skipTLSVerify {
+		return hc.transports[1]
+	}
+	return hc.transports[0]
+}
+
+func newTransport(skipTLSVerify bool) *http.Transport {
+	return &http.Transport{
+		// #nosec G402
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: skipTLSVerify},
+		Proxy:           http.ProxyFromEnvironment, // Use proxy settings from environment
+		// Idle connections are closed eventually, so that clients that are
+		// no longer used do not hold on to them.
+		IdleConnTimeout: 90 * time.Second,
+	}
+}
+
+// readBody reads the response body, enforcing the configured size limit.
+func (hc *client) readBody(body io.Reader) ([]byte, error) {
+	if hc.maxResponseBytes <= 0 {
+		return io.ReadAll(body)
+	}
+
+	// Read one byte past the limit so an oversized body can be detected
+	// without buffering all of it.
+	data, err := io.ReadAll(io.LimitReader(body, hc.maxResponseBytes+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(data)) > hc.maxResponseBytes {
+		return nil, errors.Errorf(errResponseTooLarge, hc.maxResponseBytes)
+	}
+
+	return data, nil
+}
+
 // NewClient returns a new Http Client
-func NewClient(log logging.Logger, timeout time.Duration, authorizationToken string) (Client, error) {
-	return &client{
+func NewClient(log logging.Logger, timeout time.Duration, authorizationToken string, opts ...ClientOption) (Client, error) {
+	c := &client{
 		log:                log,
 		timeout:            timeout,
 		authorizationToken: authorizationToken,
-	}, nil
+		transports:         [2]*http.Transport{newTransport(false), newTransport(true)},
+	}
+
+	for _, o := range opts {
+		o(c)
+	}
+
+	return c, nil
 }
 
 // toJSON converts the request to a JSON string.
@@ -0,0 +1,322 @@
+package http
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func newTestServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+	s := httptest.NewServer(handler)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func sendGet(c Client, url string) (HttpDetails, error) {
+	return c.SendRequest(context.Background(), http.MethodGet, url,
+		Data{Encrypted: "", Decrypted: ""},
+		Data{Encrypted: map[string][]string{}, Decrypted: map[string][]string{}},
+		false)
+}
+
+func TestSendRequestMaxResponseBytes(t *testing.T) {
+	body := strings.Repeat("a", 16)
+
+	cases := map[string]struct {
+		opts     []ClientOption
+		wantBody string
+		wantErr  error
+	}{
+		"NoLimit": {
+			wantBody: body,
+		},
+		"WithinLimit": {
+			opts:     []ClientOption{WithMaxResponseBytes(16)},
+			wantBody: body,
+		},
+		"ExceedsLimit": {
+			opts:    []ClientOption{WithMaxResponseBytes(15)},
+			wantErr: errors.Errorf(errResponseTooLarge, 15),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			s := newTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(body))
+			})
+
+			c, _ := NewClient(logging.NewNopLogger(), time.Minute, "", tc.opts...)
+			details, err := sendGet(c, s.URL)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("SendRequest(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.wantBody, details.HttpResponse.Body); diff != "" {
+				t.Fatalf("SendRequest(...): -want body, +got body: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSendRequestContext(t *testing.T) {
+	cases := map[string]struct {
+		ctx     func() (context.Context, context.CancelFunc)
+		wantErr error
+	}{
+		"Cancelled": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				time.AfterFunc(50*time.Millisecond, cancel)
+				return ctx, cancel
+			},
+			wantErr: context.Canceled,
+		},
+		"DeadlineShorterThanTimeout": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 50*time.Millisecond)
+			},
+			wantErr: context.DeadlineExceeded,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			release := make(chan struct{})
+			s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-r.Context().Done():
+				case <-release:
+				}
+			})
+			defer close(release)
+
+			ctx, cancel := tc.ctx()
+			defer cancel()
+
+			c, _ := NewClient(logging.NewNopLogger(), time.Minute, "")
+			start := time.Now()
+			_, err := c.SendRequest(ctx, http.MethodGet, s.URL,
+				Data{Encrypted: "", Decrypted: ""},
+				Data{Encrypted: map[string][]string{}, Decrypted: map[string][]string{}},
+				false)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("SendRequest(...): want error %v, got %v", tc.wantErr, err)
+			}
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Fatalf("SendRequest(...): returned after %s, want it to return when the context is done", elapsed)
+			}
+		})
+	}
+}
+
+// rawHeaderServer accepts a single HTTP/1.1 connection and returns the raw
+// request header block it received.
+func rawHeaderServer(t *testing.T) (string, <-chan string) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen(...): %v", err)
+	}
+	t.Cleanup(func() { _ = l.Close() })
+
+	raw := make(chan string, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close() //nolint:errcheck // test connection.
+
+		var head strings.Builder
+		r := bufio.NewReader(conn)
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil || line == "\r\n" {
+				break
+			}
+			head.WriteString(line)
+		}
+		raw <- head.String()
+		_, _ = conn.Write([]byte("HTTP/1.1 200 OK\r\nContent-Length: 0\r\nConnection: close\r\n\r\n"))
+	}()
+
+	return "http://" + l.Addr().String(), raw
+}
+
+func TestSendRequestExactHeaderCasing(t *testing.T) {
+	cases := map[string]struct {
+		opts []ClientOption
+		want []string
+	}{
+		"Canonical": {
+			want: []string{"X-Request-Id: abc", "X-Tenant: t"},
+		},
+		"Allowlisted": {
+			opts: []ClientOption{WithExactHeaderCasing("X-REQUEST-ID")},
+			want: []string{"X-REQUEST-ID: abc", "X-Tenant: t"},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			url, raw := rawHeaderServer(t)
+			headers := map[string][]string{"x-request-id": {"abc"}, "x-tenant": {"t"}}
+
+			c, _ := NewClient(logging.NewNopLogger(), time.Minute, "", tc.opts...)
+			if _, err := c.SendRequest(context.Background(), http.MethodGet, url,
+				Data{Encrypted: "", Decrypted: ""},
+				Data{Encrypted: headers, Decrypted: headers},
+				false); err != nil {
+				t.Fatalf("SendRequest(...): %v", err)
+			}
+
+			got := <-raw
+			for _, h := range tc.want {
+				if !strings.Contains(got, h+"\r\n") {
+					t.Errorf("SendRequest(...): want header %q in request:\n%s", h, got)
+				}
+			}
+		})
+	}
+}
+
+func TestStream(t *testing.T) {
+	url, raw := rawHeaderServer(t)
+	headers := map[string][]string{"x-request-id": {"abc"}}
+
+	c, _ := NewClient(logging.NewNopLogger(), time.Minute, "token", WithExactHeaderCasing("X-REQUEST-ID"))
+	resp, err := c.(Streamer).Stream(context.Background(), http.MethodGet, url, Data{Encrypted: headers, Decrypted: headers}, false)
+	if err != nil {
+		t.Fatalf("Stream(...): %v", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // test response.
+
+	got := <-raw
+	for _, h := range []string{"X-REQUEST-ID: abc", "Authorization: token"} {
+		if !strings.Contains(got, h+"\r\n") {
+			t.Errorf("Stream(...): want header %q in request:\n%s", h, got)
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Stream(...): want status code 200, got %d", resp.StatusCode)
+	}
+}
+
+func TestConnectionConfig(t *testing.T) {
+	headers := map[string][]string{"x-request-id": {"abc"}}
+	c, _ := NewClient(logging.NewNopLogger(), time.Minute, "token",
+		WithExactHeaderCasing("X-REQUEST-ID"), WithTLSServerName("orders.example.com"))
+
+	h, cfg := c.(Streamer).ConnectionConfig(Data{Encrypted: headers, Decrypted: headers}, false)
+	want := http.Header{"X-REQUEST-ID": {"abc"}, "Authorization": {"token"}}
+	if diff := cmp.Diff(want, h); diff != "" {
+		t.Errorf("ConnectionConfig(...): -want headers, +got headers: %s", diff)
+	}
+	if cfg.ServerName != "orders.example.com" {
+		t.Errorf("ConnectionConfig(...): want TLS server name orders.example.com, got %q", cfg.ServerName)
+	}
+}
+
+func TestSendRequestTLSServerName(t *testing.T) {
+	cases := map[string]struct {
+		opts []ClientOption
+		want string
+	}{
+		// No server name is sent for an IP address.
+		"URLHost": {
+			want: "",
+		},
+		"Override": {
+			opts: []ClientOption{WithTLSServerName("orders.example.com")},
+			want: "orders.example.com",
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			serverNames := make(chan string, 1)
+			s := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+			s.TLS = &tls.Config{
+				GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+					serverNames <- hello.ServerName
+					return nil, nil
+				},
+			}
+			s.StartTLS()
+			t.Cleanup(s.Close)
+
+			c, _ := NewClient(logging.NewNopLogger(), time.Minute, "", tc.opts...)
+			if _, err := c.SendRequest(context.Background(), http.MethodGet, s.URL,
+				Data{Encrypted: "", Decrypted: ""},
+				Data{Encrypted: map[string][]string{}, Decrypted: map[string][]string{}},
+				true); err != nil {
+				t.Fatalf("SendRequest(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, <-serverNames); diff != "" {
+				t.Fatalf("SendRequest(...): -want server name, +got server name: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSendRequestRedirects(t *testing.T) {
+	type want struct {
+		statusCode int
+		redirected string
+	}
+
+	cases := map[string]struct {
+		opts []ClientOption
+		want want
+	}{
+		"Followed": {
+			want: want{statusCode: http.StatusOK, redirected: "/new"},
+		},
+		"NotFollowed": {
+			opts: []ClientOption{WithFollowRedirects(false)},
+			want: want{statusCode: http.StatusFound},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/old" {
+					http.Redirect(w, r, "/new", http.StatusFound)
+				}
+			})
+
+			c, _ := NewClient(logging.NewNopLogger(), time.Minute, "", tc.opts...)
+			got, err := sendGet(c, s.URL+"/old")
+			if err != nil {
+				t.Fatalf("SendRequest(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want.statusCode, got.HttpResponse.StatusCode); diff != "" {
+				t.Errorf("SendRequest(...): -want status code, +got status code: %s", diff)
+			}
+			if tc.want.redirected != "" {
+				tc.want.redirected = s.URL + tc.want.redirected
+			}
+			if diff := cmp.Diff(tc.want.redirected, got.HttpResponse.RedirectedURL); diff != "" {
+				t.Errorf("SendRequest(...): -want redirected URL, +got redirected URL: %s", diff)
+			}
+		})
+	}
+}
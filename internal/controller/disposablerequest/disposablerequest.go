@@ -94,7 +94,7 @@ type connector struct {
 	logger          logging.Logger
 	kube            client.Client
 	usage           resource.Tracker
-	newHttpClientFn func(log logging.Logger, timeout time.Duration, creds string) (httpClient.Client, error)
+	newHttpClientFn func(log logging.Logger, timeout time.Duration, creds string, opts ...httpClient.ClientOption) (httpClient.Client, error)
 }
 
 // Connect returns a new ExternalClient.
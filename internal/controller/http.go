@@ -24,12 +24,13 @@ import (
 
 	"github.com/crossplane-contrib/provider-http/internal/controller/config"
 	disposablerequest "github.com/crossplane-contrib/provider-http/internal/controller/disposablerequest"
+	"github.com/crossplane-contrib/provider-http/internal/controller/network"
 	request "github.com/crossplane-contrib/provider-http/internal/controller/request"
 )
 
 // Setup creates all http controllers with the supplied logger and adds them to
 // the supplied manager.
-func Setup(mgr ctrl.Manager, o controller.Options, timeout time.Duration) error {
+func Setup(mgr ctrl.Manager, o controller.Options, timeout time.Duration, no network.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options, time.Duration) error{
 		config.Setup,
 		disposablerequest.Setup,
@@ -39,5 +40,5 @@ func Setup(mgr ctrl.Manager, o controller.Options, timeout time.Duration) error
 			return err
 		}
 	}
-	return nil
+	return network.Setup(mgr, o, timeout, no)
 }
@@ -0,0 +1,55 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+// recordStatusAge records since when the order is in the supplied status,
+// and how long that is as of now. The time the backend reports the status
+// changed is used if it has one. Otherwise the time the status was first
+// observed is tracked, which is now if the status changed since the last
+// observation. The observations in the status are counted as well.
+func recordStatusAge(cr *v1alpha1.PortOrder, status, changedAt string, now time.Time) {
+	o := &cr.Status.AtProvider
+	if status != o.Status {
+		o.PollsInStatus = 0
+	} else {
+		o.PollsInStatus++
+	}
+
+	switch since := lastModified(changedAt); {
+	case since != nil:
+		o.StatusSince = since
+	case o.StatusSince == nil || status != o.Status:
+		t := metav1.NewTime(now)
+		o.StatusSince = &t
+	}
+
+	// A backend clock ahead of ours would otherwise yield a negative age.
+	age := now.Sub(o.StatusSince.Time)
+	if age < 0 {
+		age = 0
+	}
+	o.StatusAge = duration.HumanDuration(age)
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+func Test_recordStatusAge(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *metav1.Time {
+		t := metav1.NewTime(now.Add(-d))
+		return &t
+	}
+	observed := func(status string, since *metav1.Time) portOrderModifier {
+		return func(po *v1alpha1.PortOrder) {
+			po.Status.AtProvider.Status = status
+			po.Status.AtProvider.StatusSince = since
+		}
+	}
+
+	type want struct {
+		since *metav1.Time
+		age   string
+		polls int
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.PortOrder
+		status    string
+		changedAt string
+		want      want
+	}{
+		"FirstObservation": {
+			cr:     portOrder(),
+			status: "pending",
+			want:   want{since: at(0), age: "0s"},
+		},
+		"Unchanged": {
+			cr:     portOrder(observed("pending", at(3*time.Hour+25*time.Minute))),
+			status: "pending",
+			want:   want{since: at(3*time.Hour + 25*time.Minute), age: "3h25m", polls: 1},
+		},
+		"Changed": {
+			cr: portOrder(observed("pending", at(3*time.Hour)), func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.PollsInStatus = 5
+			}),
+			status: "active",
+			want:   want{since: at(0), age: "0s"},
+		},
+		"ReportedByBackend": {
+			cr:        portOrder(observed("pending", at(time.Minute))),
+			status:    "pending",
+			changedAt: "2025-05-30T12:00:00Z",
+			want:      want{since: at(48 * time.Hour), age: "2d", polls: 1},
+		},
+		"BackendClockAhead": {
+			cr:        portOrder(),
+			status:    "pending",
+			changedAt: "2025-06-01T12:05:00Z",
+			want:      want{since: at(-5 * time.Minute), age: "0s"},
+		},
+		"MalformedReportedTime": {
+			cr:        portOrder(observed("pending", at(10*time.Minute))),
+			status:    "pending",
+			changedAt: "yesterday",
+			want:      want{since: at(10 * time.Minute), age: "10m", polls: 1},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			recordStatusAge(tc.cr, tc.status, tc.changedAt, now)
+			if diff := cmp.Diff(tc.want.since.Time.UTC(), tc.cr.Status.AtProvider.StatusSince.Time.UTC()); diff != "" {
+				t.Errorf("recordStatusAge(...): -want StatusSince, +got StatusSince: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.age, tc.cr.Status.AtProvider.StatusAge); diff != "" {
+				t.Errorf("recordStatusAge(...): -want StatusAge, +got StatusAge: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.polls, tc.cr.Status.AtProvider.PollsInStatus); diff != "" {
+				t.Errorf("recordStatusAge(...): -want PollsInStatus, +got PollsInStatus: %s", diff)
+			}
+		})
+	}
+}
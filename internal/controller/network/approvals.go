@@ -0,0 +1,53 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+// approvedStatuses are the statuses of an approval that is done.
+var approvedStatuses = []string{"approved", "completed", "complete"}
+
+// orderApproval is a step of the approval chain of an order.
+type orderApproval struct {
+	Approver string `json:"approver,omitempty"`
+	Status   string `json:"status"`
+}
+
+// recordApprovals summarizes the approval chain of the order in its status.
+// Orders the backend reports no approvals for are left unchanged.
+func recordApprovals(cr *v1alpha1.PortOrder, approvals []orderApproval) {
+	if len(approvals) == 0 {
+		return
+	}
+
+	pending := 0
+	for _, a := range approvals {
+		if !hasStatus(approvedStatuses, a.Status) {
+			pending++
+		}
+	}
+
+	cr.Status.AtProvider.ApprovalsPending = pending
+	cr.Status.AtProvider.ApprovalsTotal = len(approvals)
+	if pending > 0 {
+		cr.SetConditions(v1alpha1.ApprovalsPending(pending, len(approvals)))
+		return
+	}
+	cr.SetConditions(v1alpha1.Approved())
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+func Test_external_Observe_Approvals(t *testing.T) {
+	type want struct {
+		pending   int
+		total     int
+		condition xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		body string
+		want want
+	}{
+		"NoApprovals": {
+			body: `{"orderId":"order-123","status":"pending"}`,
+			want: want{condition: xpv1.Condition{Type: v1alpha1.TypeApproved, Status: "Unknown"}},
+		},
+		"Pending": {
+			body: `{"orderId":"order-123","status":"pending","approvals":[` +
+				`{"approver":"netsec","status":"Approved"},{"approver":"cab","status":"pending"},{"status":"requested"}]}`,
+			want: want{pending: 2, total: 3, condition: v1alpha1.ApprovalsPending(2, 3)},
+		},
+		"AllDone": {
+			body: `{"orderId":"order-123","status":"active","approvals":[` +
+				`{"approver":"netsec","status":"approved"},{"approver":"cab","status":"completed"}]}`,
+			want: want{total: 2, condition: v1alpha1.Approved()},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: respondWith(200, tc.body)},
+				logger: logging.NewNopLogger(),
+			}
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.OrderID = testOrderID
+			})
+			if _, err := e.Observe(context.Background(), cr); err != nil {
+				t.Fatalf("e.Observe(...): %v", err)
+			}
+
+			got := want{
+				pending:   cr.Status.AtProvider.ApprovalsPending,
+				total:     cr.Status.AtProvider.ApprovalsTotal,
+				condition: cr.GetCondition(v1alpha1.TypeApproved),
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Fatalf("e.Observe(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,359 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+	"github.com/crossplane-contrib/provider-http/internal/utils"
+)
+
+const (
+	authTypeOAuth2    = "oauth2"
+	authTypeSignedURL = "signed-url"
+	authTypeHMAC      = "hmac"
+
+	// signatureHeader and signatureTimestampHeader carry the HMAC signature
+	// of a request and the Unix time it was signed at.
+	signatureHeader          = "X-Signature"
+	signatureTimestampHeader = "X-Signature-Timestamp"
+
+	errTokenRequest     = "cannot request OAuth2 token"
+	errTokenStatusCode  = "OAuth2 token endpoint returned status code %d"
+	errTokenUnmarshal   = "cannot unmarshal OAuth2 token response"
+	errTokenMissing     = "OAuth2 token response does not contain an access token"
+	errTokenURLRequired = "tokenUrl is required for the oauth2 auth type"
+
+	errSignRequest        = "cannot request signed URL"
+	errSignStatusCode     = "URL signing service returned status code %d"
+	errSignUnmarshal      = "cannot unmarshal URL signing service response"
+	errSignMissing        = "URL signing service response does not contain a signed URL"
+	errSigningURLRequired = "signingUrl is required for the signed-url auth type"
+
+	errSigningKeyRequired = "signingKey is required for the hmac auth type"
+
+	errTLSServerNameEmpty = "tlsServerName must not be empty when set"
+
+	errGetOrderCreds   = "cannot get PortOrder credentials secret %s/%s"
+	errOrderCredsNoKey = "PortOrder credentials secret %s/%s has no key %q"
+
+	errEnvCredsNoName = "credentials source Environment requires the name of an environment variable"
+	errEnvCredsEmpty  = "credentials environment variable %s is not set or empty"
+
+	// tokenExpiryLeeway is subtracted from the token lifetime so a token is
+	// refreshed shortly before the backend starts rejecting it.
+	tokenExpiryLeeway = 30 * time.Second
+)
+
+// credentialsConfig is the content of the ProviderConfig credentials secret.
+type credentialsConfig struct {
+	AuthType    string            `json:"authType,omitempty"`
+	Credentials string            `json:"credentials,omitempty"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	Timeout     *time.Duration    `json:"timeout,omitempty"`
+
+	// ExactCaseHeaders are header names sent with exactly this casing, for
+	// backends that do not accept canonicalized header names.
+	ExactCaseHeaders []string `json:"exactCaseHeaders,omitempty"`
+
+	// TLSServerName is the name the certificate of the orders API is
+	// verified against, and sent as SNI, when it differs from the host the
+	// API is reached at, e.g. an IP address.
+	TLSServerName *string `json:"tlsServerName,omitempty"`
+
+	// OAuth2 client credentials, used when AuthType is oauth2.
+	TokenURL     string   `json:"tokenUrl,omitempty"`
+	ClientID     string   `json:"clientId,omitempty"`
+	ClientSecret string   `json:"clientSecret,omitempty"`
+	Scopes       []string `json:"scopes,omitempty"`
+
+	// SigningURL is the URL signing service, used when AuthType is
+	// signed-url.
+	SigningURL string `json:"signingUrl,omitempty"`
+
+	// SigningKey signs requests when AuthType is hmac. SecondarySigningKey
+	// is tried when the backend rejects the signature, so that both keys
+	// are accepted while they are rotated.
+	SigningKey          string `json:"signingKey,omitempty"`
+	SecondarySigningKey string `json:"secondarySigningKey,omitempty"`
+
+	// EncryptionPublicKey is the PEM encoded RSA public key of the orders
+	// API that request bodies are encrypted to when the order encrypts its
+	// payloads, identified to the backend by EncryptionKeyID if set.
+	// DecryptionPrivateKey is the PEM encoded RSA private key the backend
+	// encrypts its responses to, if it does.
+	EncryptionPublicKey  string `json:"encryptionPublicKey,omitempty"`
+	EncryptionKeyID      string `json:"encryptionKeyId,omitempty"`
+	DecryptionPrivateKey string `json:"decryptionPrivateKey,omitempty"`
+}
+
+// orderCredentials returns the credentials of the referenced secret key,
+// which override the ProviderConfig credentials of a PortOrder.
+func orderCredentials(ctx context.Context, kube client.Client, ref xpv1.SecretKeySelector) (string, error) {
+	return secretValue(ctx, kube, ref, errGetOrderCreds, errOrderCredsNoKey)
+}
+
+// envCredentials returns the credentials held by the selected environment
+// variable. It holds either the same JSON document as a credentials secret,
+// or just the API token.
+func envCredentials(s xpv1.CommonCredentialSelectors) (string, error) {
+	if s.Env == nil || s.Env.Name == "" {
+		return "", errors.New(errEnvCredsNoName)
+	}
+	v, ok := os.LookupEnv(s.Env.Name)
+	v = strings.TrimSpace(v)
+	if !ok || v == "" {
+		return "", errors.Errorf(errEnvCredsEmpty, s.Env.Name)
+	}
+	if strings.HasPrefix(v, "{") {
+		return v, nil
+	}
+	doc, err := json.Marshal(credentialsConfig{Credentials: v})
+	return string(doc), errors.Wrap(err, errGetCreds)
+}
+
+// secretValue returns the value of the referenced secret key. errGet and
+// errNoKey describe the secret in the errors returned when it cannot be read
+// or does not have the key.
+func secretValue(ctx context.Context, kube client.Client, ref xpv1.SecretKeySelector, errGet, errNoKey string) (string, error) {
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return "", errors.Wrapf(err, errGet, ref.Namespace, ref.Name)
+	}
+	data, ok := s.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf(errNoKey, ref.Namespace, ref.Name, ref.Key)
+	}
+	return string(data), nil
+}
+
+// tokenResponse is the OAuth2 token endpoint response format.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in,omitempty"`
+}
+
+type cachedToken struct {
+	value  string
+	expiry time.Time
+}
+
+// tokenCache holds OAuth2 access tokens across reconciles, keyed by token
+// endpoint and client ID.
+type tokenCache struct {
+	mu     sync.Mutex
+	tokens map[string]cachedToken
+}
+
+func newTokenCache() *tokenCache {
+	return &tokenCache{tokens: map[string]cachedToken{}}
+}
+
+func (c *tokenCache) get(key string, now time.Time) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t, ok := c.tokens[key]
+	if !ok || (!t.expiry.IsZero() && now.After(t.expiry)) {
+		return "", false
+	}
+	return t.value, true
+}
+
+func (c *tokenCache) set(key string, t cachedToken) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tokens[key] = t
+}
+
+func (c *tokenCache) invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.tokens, key)
+}
+
+// tokenSource obtains OAuth2 access tokens using the client credentials grant.
+type tokenSource struct {
+	client httpclient.Client
+	cache  *tokenCache
+	config credentialsConfig
+}
+
+func (s *tokenSource) key() string {
+	return s.config.TokenURL + "|" + s.config.ClientID
+}
+
+// Token returns a cached access token, requesting a new one when none is
+// cached or when refresh is set.
+func (s *tokenSource) Token(ctx context.Context, refresh bool) (string, error) {
+	if refresh {
+		s.cache.invalidate(s.key())
+	} else if token, ok := s.cache.get(s.key(), time.Now()); ok {
+		return token, nil
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", s.config.ClientID)
+	if len(s.config.Scopes) > 0 {
+		form.Set("scope", strings.Join(s.config.Scopes, " "))
+	}
+	redacted := form.Encode()
+	form.Set("client_secret", s.config.ClientSecret)
+
+	headers := map[string][]string{"Content-Type": {"application/x-www-form-urlencoded"}}
+	details, err := s.client.SendRequest(ctx, http.MethodPost, s.config.TokenURL,
+		httpclient.Data{Encrypted: redacted, Decrypted: form.Encode()},
+		httpclient.Data{Encrypted: headers, Decrypted: headers},
+		false)
+	if err != nil {
+		return "", errors.Wrap(err, errTokenRequest)
+	}
+
+	if !utils.IsHTTPSuccess(details.HttpResponse.StatusCode) {
+		return "", errors.Errorf(errTokenStatusCode, details.HttpResponse.StatusCode)
+	}
+
+	var resp tokenResponse
+	if err := json.Unmarshal([]byte(details.HttpResponse.Body), &resp); err != nil {
+		return "", errors.Wrap(err, errTokenUnmarshal)
+	}
+	if resp.AccessToken == "" {
+		return "", errors.New(errTokenMissing)
+	}
+
+	t := cachedToken{value: resp.AccessToken}
+	if resp.ExpiresIn > 0 {
+		t.expiry = time.Now().Add(time.Duration(resp.ExpiresIn)*time.Second - tokenExpiryLeeway)
+	}
+	s.cache.set(s.key(), t)
+
+	return t.value, nil
+}
+
+// signRequest is the URL signing service request format.
+type signRequest struct {
+	URL    string `json:"url"`
+	Method string `json:"method"`
+}
+
+// signResponse is the URL signing service response format.
+type signResponse struct {
+	SignedURL string `json:"signedUrl"`
+	ExpiresIn int64  `json:"expiresIn,omitempty"`
+}
+
+// urlSigner obtains time-limited signed URLs from a URL signing service.
+type urlSigner struct {
+	client httpclient.Client
+	cache  *tokenCache
+	config credentialsConfig
+}
+
+// Sign returns a cached signed URL for the request, requesting a new one when
+// none is cached or when refresh is set.
+func (s *urlSigner) Sign(ctx context.Context, method, rawURL string, refresh bool) (string, error) {
+	key := s.config.SigningURL + "|" + method + "|" + rawURL
+	if refresh {
+		s.cache.invalidate(key)
+	} else if signed, ok := s.cache.get(key, time.Now()); ok {
+		return signed, nil
+	}
+
+	body, err := json.Marshal(signRequest{URL: rawURL, Method: method})
+	if err != nil {
+		return "", errors.Wrap(err, errSignRequest)
+	}
+	headers := map[string][]string{"Content-Type": {"application/json"}}
+	details, err := s.client.SendRequest(ctx, http.MethodPost, s.config.SigningURL,
+		httpclient.Data{Encrypted: string(body), Decrypted: string(body)},
+		httpclient.Data{Encrypted: headers, Decrypted: headers},
+		false)
+	if err != nil {
+		return "", errors.Wrap(err, errSignRequest)
+	}
+
+	if !utils.IsHTTPSuccess(details.HttpResponse.StatusCode) {
+		return "", errors.Errorf(errSignStatusCode, details.HttpResponse.StatusCode)
+	}
+
+	var resp signResponse
+	if err := json.Unmarshal([]byte(details.HttpResponse.Body), &resp); err != nil {
+		return "", errors.Wrap(err, errSignUnmarshal)
+	}
+	if resp.SignedURL == "" {
+		return "", errors.New(errSignMissing)
+	}
+
+	t := cachedToken{value: resp.SignedURL}
+	if resp.ExpiresIn > 0 {
+		t.expiry = time.Now().Add(time.Duration(resp.ExpiresIn)*time.Second - tokenExpiryLeeway)
+	}
+	s.cache.set(key, t)
+
+	return t.value, nil
+}
+
+// hmacSigner signs requests with HMAC-SHA256 over the method, URL, signing
+// time and body of the request.
+type hmacSigner struct {
+	primary   string
+	secondary string
+}
+
+// Sign returns the signature headers of the request, signed with the
+// secondary key if secondary is set.
+func (s *hmacSigner) Sign(method, rawURL, body string, now time.Time, secondary bool) map[string][]string {
+	key := s.primary
+	if secondary {
+		key = s.secondary
+	}
+	ts := strconv.FormatInt(now.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(method + "\n" + rawURL + "\n" + ts + "\n" + body))
+	return map[string][]string{
+		signatureHeader:          {hex.EncodeToString(mac.Sum(nil))},
+		signatureTimestampHeader: {ts},
+	}
+}
+
+// signatureRejected reports whether the response rejects the signature of a
+// request, in which case it may be accepted when signed with the secondary
+// key.
+func (s *hmacSigner) signatureRejected(resp httpclient.HttpResponse) bool {
+	return s.secondary != "" && resp.StatusCode == http.StatusUnauthorized &&
+		strings.Contains(strings.ToLower(resp.Body), "signature")
+}
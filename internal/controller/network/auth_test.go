@@ -0,0 +1,429 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	apisv1alpha1 "github.com/crossplane-contrib/provider-http/apis/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+func Test_connector_Connect_CredentialsSecretRef(t *testing.T) {
+	ref := &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Namespace: "tenant-a", Name: "order-creds"},
+		Key:             "credentials",
+	}
+
+	type want struct {
+		token string
+		err   error
+	}
+
+	cases := map[string]struct {
+		ref    *xpv1.SecretKeySelector
+		secret *corev1.Secret
+		want   want
+	}{
+		"ProviderConfigCredentials": {
+			want: want{token: ""},
+		},
+		"Override": {
+			ref: ref,
+			secret: &corev1.Secret{Data: map[string][]byte{
+				"credentials": []byte(`{"credentials":"Bearer tenant-a"}`),
+			}},
+			want: want{token: "Bearer tenant-a"},
+		},
+		"SecretMissing": {
+			ref: ref,
+			want: want{err: errors.Wrapf(
+				kerrors.NewNotFound(corev1.Resource("secrets"), "order-creds"),
+				errGetOrderCreds, "tenant-a", "order-creds")},
+		},
+		"KeyMissing": {
+			ref:    ref,
+			secret: &corev1.Secret{Data: map[string][]byte{"other": nil}},
+			want:   want{err: errors.Errorf(errOrderCredsNoKey, "tenant-a", "order-creds", "credentials")},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+					switch o := obj.(type) {
+					case *apisv1alpha1.ProviderConfig:
+						o.Spec.Credentials.Source = xpv1.CredentialsSourceNone
+						return nil
+					case *corev1.Secret:
+						if tc.secret == nil {
+							return kerrors.NewNotFound(corev1.Resource("secrets"), key.Name)
+						}
+						tc.secret.DeepCopyInto(o)
+						return nil
+					}
+					return errBoom
+				},
+			}
+
+			var gotToken string
+			c := &connector{
+				kube:   kube,
+				usage:  resource.TrackerFn(func(_ context.Context, _ resource.Managed) error { return nil }),
+				logger: logging.NewNopLogger(),
+				newHttpClientFn: func(_ logging.Logger, _ time.Duration, creds string, _ ...httpclient.ClientOption) (httpclient.Client, error) {
+					gotToken = creds
+					return &MockHttpClient{}, nil
+				},
+			}
+
+			_, err := c.Connect(context.Background(), portOrder(func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.CredentialsSecretRef = tc.ref
+			}))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("c.Connect(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.token, gotToken); diff != "" {
+				t.Fatalf("c.Connect(...): -want token, +got token: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_connector_Connect_TLSServerName(t *testing.T) {
+	cases := map[string]struct {
+		credentials string
+		want        error
+	}{
+		"Unset": {
+			credentials: `{"credentials":"Bearer token"}`,
+		},
+		"Set": {
+			credentials: `{"credentials":"Bearer token","tlsServerName":"orders.example.com"}`,
+		},
+		"Blank": {
+			credentials: `{"credentials":"Bearer token","tlsServerName":"  "}`,
+			want:        errors.New(errTLSServerNameEmpty),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					switch o := obj.(type) {
+					case *apisv1alpha1.ProviderConfig:
+						o.Spec.Credentials.Source = xpv1.CredentialsSourceNone
+						return nil
+					case *corev1.Secret:
+						o.Data = map[string][]byte{"credentials": []byte(tc.credentials)}
+						return nil
+					}
+					return errBoom
+				},
+			}
+			c := &connector{
+				kube:   kube,
+				usage:  resource.TrackerFn(func(_ context.Context, _ resource.Managed) error { return nil }),
+				logger: logging.NewNopLogger(),
+				newHttpClientFn: func(_ logging.Logger, _ time.Duration, _ string, _ ...httpclient.ClientOption) (httpclient.Client, error) {
+					return &MockHttpClient{}, nil
+				},
+			}
+
+			_, err := c.Connect(context.Background(), portOrder(func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.CredentialsSecretRef = &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Namespace: "tenant-a", Name: "order-creds"},
+					Key:             "credentials",
+				}
+			}))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("c.Connect(...): -want error, +got error: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_connector_Connect_EnvironmentCredentials(t *testing.T) {
+	const envName = "PORTORDER_TEST_CREDENTIALS"
+
+	type want struct {
+		token string
+		err   error
+	}
+
+	cases := map[string]struct {
+		env  *xpv1.EnvSelector
+		set  bool
+		val  string
+		want want
+	}{
+		"Token": {
+			env:  &xpv1.EnvSelector{Name: envName},
+			set:  true,
+			val:  " Bearer from-env\n",
+			want: want{token: "Bearer from-env"},
+		},
+		"Document": {
+			env:  &xpv1.EnvSelector{Name: envName},
+			set:  true,
+			val:  `{"credentials":"Bearer from-doc"}`,
+			want: want{token: "Bearer from-doc"},
+		},
+		"Unset": {
+			env:  &xpv1.EnvSelector{Name: envName},
+			want: want{err: errors.Errorf(errEnvCredsEmpty, envName)},
+		},
+		"Empty": {
+			env:  &xpv1.EnvSelector{Name: envName},
+			set:  true,
+			val:  "  ",
+			want: want{err: errors.Errorf(errEnvCredsEmpty, envName)},
+		},
+		"NoName": {
+			want: want{err: errors.New(errEnvCredsNoName)},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			if tc.set {
+				t.Setenv(envName, tc.val)
+			}
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					if o, ok := obj.(*apisv1alpha1.ProviderConfig); ok {
+						o.Spec.Credentials.Source = xpv1.CredentialsSourceEnvironment
+						o.Spec.Credentials.Env = tc.env
+						return nil
+					}
+					return errBoom
+				},
+			}
+
+			var gotToken string
+			c := &connector{
+				kube:   kube,
+				usage:  resource.TrackerFn(func(_ context.Context, _ resource.Managed) error { return nil }),
+				logger: logging.NewNopLogger(),
+				newHttpClientFn: func(_ logging.Logger, _ time.Duration, creds string, _ ...httpclient.ClientOption) (httpclient.Client, error) {
+					gotToken = creds
+					return &MockHttpClient{}, nil
+				},
+			}
+
+			_, err := c.Connect(context.Background(), portOrder())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("c.Connect(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.token, gotToken); diff != "" {
+				t.Fatalf("c.Connect(...): -want token, +got token: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_send_SignedURL(t *testing.T) {
+	const signingURL = "https://signer.example.com/sign"
+
+	type want struct {
+		statusCode   int
+		urls         []string
+		signRequests int
+		err          error
+	}
+
+	cases := map[string]struct {
+		// validURL is the only signed URL the orders API accepts.
+		validURL string
+		signCode int
+		want     want
+	}{
+		"Signed": {
+			validURL: testEndpoint + "?sig=1",
+			signCode: 200,
+			want: want{
+				statusCode:   201,
+				urls:         []string{testEndpoint + "?sig=1"},
+				signRequests: 1,
+			},
+		},
+		"ExpiredURLRefreshed": {
+			validURL: testEndpoint + "?sig=2",
+			signCode: 200,
+			want: want{
+				statusCode:   201,
+				urls:         []string{testEndpoint + "?sig=1", testEndpoint + "?sig=2"},
+				signRequests: 2,
+			},
+		},
+		"SigningFailed": {
+			signCode: 500,
+			want: want{
+				err:          errors.Wrap(errors.Errorf(errSignStatusCode, 500), errSignURL),
+				signRequests: 1,
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			h := &MockHttpClient{
+				MockSendRequest: func(_ context.Context, _ string, url string, _ httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+					if url == signingURL {
+						got.signRequests++
+						return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{
+							StatusCode: tc.signCode,
+							Body:       fmt.Sprintf(`{"signedUrl":"%s?sig=%d","expiresIn":300}`, testEndpoint, got.signRequests),
+						}}, nil
+					}
+					got.urls = append(got.urls, url)
+					if url != tc.validURL {
+						return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 403}}, nil
+					}
+					return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 201}}, nil
+				},
+			}
+			e := &external{
+				client: h,
+				logger: logging.NewNopLogger(),
+				signer: &urlSigner{
+					client: h,
+					cache:  newTokenCache(),
+					config: credentialsConfig{AuthType: authTypeSignedURL, SigningURL: signingURL},
+				},
+			}
+
+			details, err := e.send(context.Background(), http.MethodPost, testEndpoint, "{}", nil)
+			got.err = err
+			got.statusCode = details.HttpResponse.StatusCode
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors(), cmp.AllowUnexported(want{})); diff != "" {
+				t.Fatalf("e.send(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_send_HMAC(t *testing.T) {
+	const mismatch = `{"error":"signature mismatch"}`
+
+	// signedWith returns the key the request headers were signed with.
+	signedWith := func(headers httpclient.Data, method, url, body string, keys ...string) string {
+		h := headers.Decrypted.(map[string][]string)
+		for _, key := range keys {
+			mac := hmac.New(sha256.New, []byte(key))
+			mac.Write([]byte(method + "\n" + url + "\n" + h[signatureTimestampHeader][0] + "\n" + body))
+			if hex.EncodeToString(mac.Sum(nil)) == h[signatureHeader][0] {
+				return key
+			}
+		}
+		return ""
+	}
+
+	type want struct {
+		statusCode int
+		keys       []string
+	}
+
+	cases := map[string]struct {
+		secondary string
+		// accepted is the only key the orders API accepts.
+		accepted string
+		// rejection is the body of the response to an unaccepted key.
+		rejection string
+		want      want
+	}{
+		"Primary": {
+			secondary: "new",
+			accepted:  "old",
+			rejection: mismatch,
+			want:      want{statusCode: 201, keys: []string{"old"}},
+		},
+		"SecondaryAfterMismatch": {
+			secondary: "new",
+			accepted:  "new",
+			rejection: mismatch,
+			want:      want{statusCode: 201, keys: []string{"old", "new"}},
+		},
+		"NoSecondary": {
+			accepted:  "new",
+			rejection: mismatch,
+			want:      want{statusCode: 401, keys: []string{"old"}},
+		},
+		"OtherUnauthorized": {
+			secondary: "new",
+			accepted:  "new",
+			rejection: `{"error":"account disabled"}`,
+			want:      want{statusCode: 401, keys: []string{"old"}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			e := &external{
+				logger: logging.NewNopLogger(),
+				hmac:   &hmacSigner{primary: "old", secondary: tc.secondary},
+				client: &MockHttpClient{
+					MockSendRequest: func(_ context.Context, method string, url string, body httpclient.Data, headers httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+						key := signedWith(headers, method, url, body.Decrypted.(string), "old", "new")
+						got.keys = append(got.keys, key)
+						if key != tc.accepted {
+							return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 401, Body: tc.rejection}}, nil
+						}
+						return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 201}}, nil
+					},
+				},
+			}
+
+			details, err := e.send(context.Background(), http.MethodPost, testEndpoint, `{"order":{}}`, nil)
+			if err != nil {
+				t.Fatalf("e.send(...): %v", err)
+			}
+			got.statusCode = details.HttpResponse.StatusCode
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Fatalf("e.send(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+// connectBackoff tracks the PortOrders that failed to connect to the
+// backend, e.g. because their credentials are misconfigured, so that they are
+// retried with an exponentially increasing delay rather than at the rate of
+// any other reconcile error. Such errors rarely resolve quickly. A nil
+// connectBackoff tracks nothing.
+type connectBackoff struct {
+	base, limit time.Duration
+
+	mu       sync.Mutex
+	failures map[types.NamespacedName]int
+}
+
+// newConnectBackoff returns a connectBackoff whose delay starts at base and
+// doubles per failure up to limit, or nil if base is not positive.
+func newConnectBackoff(base, limit time.Duration) *connectBackoff {
+	if base <= 0 {
+		return nil
+	}
+	return &connectBackoff{base: base, limit: limit, failures: map[types.NamespacedName]int{}}
+}
+
+// failed records a failed connect of the named PortOrder, and returns the
+// number of failures in a row and the delay until it is retried.
+func (b *connectBackoff) failed(name types.NamespacedName) (int, time.Duration) {
+	if b == nil {
+		return 0, 0
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures[name]++
+	n := b.failures[name]
+	return n, b.delayOf(n)
+}
+
+// succeeded forgets the failed connects of the named PortOrder, and reports
+// whether it had any.
+func (b *connectBackoff) succeeded(name types.NamespacedName) bool {
+	if b == nil {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, ok := b.failures[name]
+	delete(b.failures, name)
+	return ok
+}
+
+// forget stops tracking the failed connects of the deleted PortOrder, which
+// never connects again to clear them.
+func (b *connectBackoff) forget(o client.Object) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.failures, types.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()})
+}
+
+// tracker returns the predicate forgetting PortOrders on their delete
+// events. It accepts every event, so it has to come before any predicate
+// that filters them.
+func (b *connectBackoff) tracker() predicate.Predicate {
+	if b == nil {
+		return predicate.Funcs{}
+	}
+	return predicate.Funcs{
+		DeleteFunc: func(e event.DeleteEvent) bool { b.forget(e.Object); return true },
+	}
+}
+
+// delay returns how long the named PortOrder is to wait before it is
+// reconciled again, or zero if it did not fail to connect.
+func (b *connectBackoff) delay(name types.NamespacedName) time.Duration {
+	if b == nil {
+		return 0
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.delayOf(b.failures[name])
+}
+
+func (b *connectBackoff) delayOf(failures int) time.Duration {
+	if failures == 0 {
+		return 0
+	}
+	d := b.base
+	for i := 1; i < failures; i++ {
+		if b.limit > 0 && d >= b.limit/2 {
+			return b.limit
+		}
+		d *= 2
+	}
+	if b.limit > 0 && d > b.limit {
+		return b.limit
+	}
+	return d
+}
+
+// recordConnect records whether connecting to the backend for the PortOrder
+// failed with the supplied error in the ConnectBackoff condition.
+func (c *connector) recordConnect(cr *v1alpha1.PortOrder, err error) {
+	name := types.NamespacedName{Namespace: cr.GetNamespace(), Name: cr.GetName()}
+	if err != nil {
+		if n, d := c.backoff.failed(name); n > 0 {
+			cr.SetConditions(v1alpha1.ConnectBackoff(n, d, c.redactor.redact(err.Error())))
+		}
+		return
+	}
+	if c.backoff.succeeded(name) || cr.GetCondition(v1alpha1.TypeConnectBackoff).Status == corev1.ConditionTrue {
+		cr.SetConditions(v1alpha1.Connected())
+	}
+}
+
+// backoffReconciler requeues the PortOrders that failed to connect after the
+// delay of their connectBackoff, rather than the rate limited delay of other
+// reconcile errors.
+type backoffReconciler struct {
+	reconcile.Reconciler
+	backoff *connectBackoff
+}
+
+func (r *backoffReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	res, err := r.Reconciler.Reconcile(ctx, req)
+	if err != nil || !res.Requeue || res.RequeueAfter > 0 {
+		return res, err
+	}
+	if d := r.backoff.delay(req.NamespacedName); d > 0 {
+		return reconcile.Result{RequeueAfter: d}, nil
+	}
+	return res, err
+}
+
+// withConnectBackoff returns the reconciler requeueing with the supplied
+// backoff, or the reconciler itself if the backoff is nil.
+func withConnectBackoff(r reconcile.Reconciler, b *connectBackoff) reconcile.Reconciler {
+	if b == nil {
+		return r
+	}
+	return &backoffReconciler{Reconciler: r, backoff: b}
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	apisv1alpha1 "github.com/crossplane-contrib/provider-http/apis/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+func Test_connectBackoff_failed(t *testing.T) {
+	cases := map[string]struct {
+		limit    time.Duration
+		failures int
+		want     time.Duration
+	}{
+		"First": {
+			limit:    time.Minute,
+			failures: 1,
+			want:     10 * time.Second,
+		},
+		"Doubles": {
+			limit:    time.Minute,
+			failures: 3,
+			want:     40 * time.Second,
+		},
+		"Limited": {
+			limit:    time.Minute,
+			failures: 4,
+			want:     time.Minute,
+		},
+		"LimitedWithoutOverflow": {
+			limit:    time.Minute,
+			failures: 100,
+			want:     time.Minute,
+		},
+		"Unlimited": {
+			failures: 5,
+			want:     160 * time.Second,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			b := newConnectBackoff(10*time.Second, tc.limit)
+			n := types.NamespacedName{Name: "test-port-order"}
+			var got time.Duration
+			for i := 0; i < tc.failures; i++ {
+				_, got = b.failed(n)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("b.failed(...): -want delay, +got delay: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want, b.delay(n)); diff != "" {
+				t.Errorf("b.delay(...): -want delay, +got delay: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_connectBackoff_tracker(t *testing.T) {
+	b := newConnectBackoff(10*time.Second, time.Minute)
+	n := types.NamespacedName{Name: testPortOrderName}
+	b.failed(n)
+	b.failed(types.NamespacedName{Name: "other"})
+
+	b.tracker().Delete(event.DeleteEvent{Object: portOrder()})
+	if diff := cmp.Diff(map[types.NamespacedName]int{{Name: "other"}: 1}, b.failures); diff != "" {
+		t.Errorf("b.tracker().Delete(...): -want failures, +got failures: %s", diff)
+	}
+	if d := b.delay(n); d != 0 {
+		t.Errorf("b.delay(...): want no delay for the deleted order, got %s", d)
+	}
+}
+
+func Test_connector_Connect_Backoff(t *testing.T) {
+	type want struct {
+		err       error
+		condition xpv1.Condition
+		delay     time.Duration
+	}
+
+	cases := map[string]struct {
+		pcErr    error
+		failures int
+		cr       *v1alpha1.PortOrder
+		want     want
+	}{
+		"Failed": {
+			pcErr: errBoom,
+			cr:    portOrder(),
+			want: want{
+				err:       errors.Wrap(errBoom, errGetPC),
+				condition: v1alpha1.ConnectBackoff(1, time.Second, errors.Wrap(errBoom, errGetPC).Error()),
+				delay:     time.Second,
+			},
+		},
+		"FailedAgain": {
+			pcErr:    errBoom,
+			failures: 2,
+			cr:       portOrder(),
+			want: want{
+				err:       errors.Wrap(errBoom, errGetPC),
+				condition: v1alpha1.ConnectBackoff(3, 4*time.Second, errors.Wrap(errBoom, errGetPC).Error()),
+				delay:     4 * time.Second,
+			},
+		},
+		"Recovered": {
+			failures: 2,
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.SetConditions(v1alpha1.ConnectBackoff(2, 2*time.Second, "boom"))
+			}),
+			want: want{
+				condition: v1alpha1.Connected(),
+			},
+		},
+		"NeverFailed": {
+			cr: portOrder(),
+			want: want{
+				condition: xpv1.Condition{Type: v1alpha1.TypeConnectBackoff, Status: "Unknown"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					if o, ok := obj.(*apisv1alpha1.ProviderConfig); ok && tc.pcErr == nil {
+						o.Spec.Credentials.Source = xpv1.CredentialsSourceNone
+						return nil
+					}
+					return errBoom
+				},
+			}
+			c := &connector{
+				kube:   kube,
+				usage:  resource.TrackerFn(func(_ context.Context, _ resource.Managed) error { return nil }),
+				logger: logging.NewNopLogger(),
+				newHttpClientFn: func(_ logging.Logger, _ time.Duration, _ string, _ ...httpclient.ClientOption) (httpclient.Client, error) {
+					return &MockHttpClient{}, nil
+				},
+				backoff: newConnectBackoff(time.Second, time.Minute),
+			}
+			n := types.NamespacedName{Name: tc.cr.GetName()}
+			for i := 0; i < tc.failures; i++ {
+				c.backoff.failed(n)
+			}
+
+			_, err := c.Connect(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("c.Connect(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, tc.cr.GetCondition(v1alpha1.TypeConnectBackoff), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("c.Connect(...): -want ConnectBackoff condition, +got ConnectBackoff condition: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.delay, c.backoff.delay(n)); diff != "" {
+				t.Errorf("c.Connect(...): -want delay, +got delay: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_backoffReconciler_Reconcile(t *testing.T) {
+	cases := map[string]struct {
+		result   reconcile.Result
+		failures int
+		want     reconcile.Result
+	}{
+		"ConnectFailed": {
+			result:   reconcile.Result{Requeue: true},
+			failures: 2,
+			want:     reconcile.Result{RequeueAfter: 2 * time.Second},
+		},
+		"OtherError": {
+			result: reconcile.Result{Requeue: true},
+			want:   reconcile.Result{Requeue: true},
+		},
+		"RequeueAfter": {
+			result:   reconcile.Result{RequeueAfter: time.Minute},
+			failures: 2,
+			want:     reconcile.Result{RequeueAfter: time.Minute},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-port-order"}}
+			b := newConnectBackoff(time.Second, time.Minute)
+			for i := 0; i < tc.failures; i++ {
+				b.failed(req.NamespacedName)
+			}
+			r := withConnectBackoff(reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+				return tc.result, nil
+			}), b)
+
+			got, err := r.Reconcile(context.Background(), req)
+			if err != nil {
+				t.Fatalf("r.Reconcile(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r.Reconcile(...): -want result, +got result: %s", diff)
+			}
+		})
+	}
+}
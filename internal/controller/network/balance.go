@@ -0,0 +1,171 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+// balancerIdle is how long the round-robin position of a set of endpoints is
+// kept after it was last used.
+const balancerIdle = time.Hour
+
+// endpointBalancer keeps the round-robin position of every set of equivalent
+// endpoints, so that the PortOrders using the same endpoints take turns
+// across reconciles. Positions that were not used for balancerIdle are
+// dropped. It is safe for concurrent use.
+type endpointBalancer struct {
+	mu    sync.Mutex
+	next  map[string]*balancerPosition
+	swept time.Time
+}
+
+type balancerPosition struct {
+	next atomic.Uint64
+	used time.Time
+}
+
+func newEndpointBalancer() *endpointBalancer {
+	return &endpointBalancer{next: map[string]*balancerPosition{}}
+}
+
+// position returns the round-robin position of the set of endpoints. Idle
+// positions are dropped at most every balancerIdle.
+func (b *endpointBalancer) position(key string, now time.Time) *atomic.Uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if now.Sub(b.swept) >= balancerIdle {
+		for k, p := range b.next {
+			if now.Sub(p.used) >= balancerIdle {
+				delete(b.next, k)
+			}
+		}
+		b.swept = now
+	}
+	p := b.next[key]
+	if p == nil {
+		p = &balancerPosition{}
+		b.next[key] = p
+	}
+	p.used = now
+	return &p.next
+}
+
+// endpointPool is the set of equivalent endpoints requests of an order are
+// spread across.
+type endpointPool struct {
+	// base is the endpoint request URLs start with, which is replaced by
+	// the chosen endpoint.
+	base      string
+	endpoints []string
+	strategy  string
+	next      *atomic.Uint64
+}
+
+// pool returns the endpoints of the order, or nil if it has no equivalent
+// endpoints. A nil balancer keeps no round-robin position across pools.
+func (b *endpointBalancer) pool(p v1alpha1.PortOrderParameters) *endpointPool {
+	if len(p.Endpoints) == 0 || p.APIEndpoint == "" {
+		return nil
+	}
+	endpoints := []string{strings.TrimSuffix(p.APIEndpoint, "/")}
+	for _, e := range p.Endpoints {
+		endpoints = append(endpoints, strings.TrimSuffix(e, "/"))
+	}
+	pool := &endpointPool{base: endpoints[0], endpoints: endpoints, strategy: p.LoadBalanceStrategy}
+	if b == nil {
+		pool.next = &atomic.Uint64{}
+		return pool
+	}
+
+	pool.next = b.position(strings.Join(endpoints, " "), time.Now())
+	return pool
+}
+
+// urls returns the URL of the request at every endpoint of the pool, in the
+// order they are tried. URLs that do not start with the base endpoint are
+// not rewritten.
+func (p *endpointPool) urls(url string) []string {
+	if p == nil {
+		return []string{url}
+	}
+	path, ok := strings.CutPrefix(url, p.base)
+	if !ok || (path != "" && !strings.HasPrefix(path, "/") && !strings.HasPrefix(path, "?")) {
+		return []string{url}
+	}
+
+	first := 0
+	switch p.strategy {
+	case v1alpha1.LoadBalanceStrategyRoundRobin:
+		first = int((p.next.Add(1) - 1) % uint64(len(p.endpoints)))
+	case v1alpha1.LoadBalanceStrategyRandom:
+		first = rand.IntN(len(p.endpoints)) //nolint:gosec // not used for security.
+	}
+	urls := make([]string, 0, len(p.endpoints))
+	for i := range p.endpoints {
+		urls = append(urls, p.endpoints[(first+i)%len(p.endpoints)]+path)
+	}
+	return urls
+}
+
+// unavailable reports whether a request failed because its endpoint is
+// unavailable, so that it may be sent to another one. Write requests are only
+// sent again if they could not be sent at all, since the backend may have
+// processed them otherwise.
+func unavailable(write bool, details httpclient.HttpDetails, err error) bool {
+	if err != nil {
+		var op *net.OpError
+		return !write || (errors.As(err, &op) && op.Op == "dial")
+	}
+	if write {
+		return false
+	}
+	switch details.HttpResponse.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// sendToEndpoints sends the request to the endpoints of the order in turn,
+// until one of them is available. The response of the last endpoint is
+// returned if none is.
+func (e *external) sendToEndpoints(ctx context.Context, write bool, method, url, body string, headers map[string][]string, refresh bool) (httpclient.HttpDetails, error) {
+	urls := e.endpoints.urls(url)
+	var details httpclient.HttpDetails
+	var err error
+	for i, u := range urls {
+		details, err = e.sendOnce(ctx, method, u, body, headers, refresh)
+		if i == len(urls)-1 || !unavailable(write, details, err) {
+			break
+		}
+		e.logger.Debug("Orders API endpoint is unavailable, trying the next one", "url", withoutQuery(u), "error", err, "statusCode", details.HttpResponse.StatusCode)
+	}
+	return details, err
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+const (
+	testPrimary  = "https://eu.example.com/orders"
+	testRegional = "https://us.example.com/orders"
+)
+
+func Test_endpointPool_urls(t *testing.T) {
+	cases := map[string]struct {
+		strategy string
+		url      string
+		calls    int
+		want     [][]string
+	}{
+		"Failover": {
+			strategy: v1alpha1.LoadBalanceStrategyFailover,
+			url:      testPrimary + "/order-123",
+			calls:    2,
+			want: [][]string{
+				{testPrimary + "/order-123", testRegional + "/order-123"},
+				{testPrimary + "/order-123", testRegional + "/order-123"},
+			},
+		},
+		"RoundRobin": {
+			strategy: v1alpha1.LoadBalanceStrategyRoundRobin,
+			url:      testPrimary + "?limit=1",
+			calls:    3,
+			want: [][]string{
+				{testPrimary + "?limit=1", testRegional + "?limit=1"},
+				{testRegional + "?limit=1", testPrimary + "?limit=1"},
+				{testPrimary + "?limit=1", testRegional + "?limit=1"},
+			},
+		},
+		"OtherEndpoint": {
+			strategy: v1alpha1.LoadBalanceStrategyRoundRobin,
+			url:      "https://approvals.example.com/order-123",
+			calls:    1,
+			want:     [][]string{{"https://approvals.example.com/order-123"}},
+		},
+		"OtherPathWithSamePrefix": {
+			url:   testPrimary + "-archive/order-123",
+			calls: 1,
+			want:  [][]string{{testPrimary + "-archive/order-123"}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			b := newEndpointBalancer()
+			p := v1alpha1.PortOrderParameters{
+				APIEndpoint:         testPrimary + "/",
+				Endpoints:           []string{testRegional},
+				LoadBalanceStrategy: tc.strategy,
+			}
+			var got [][]string
+			for i := 0; i < tc.calls; i++ {
+				// Every call uses another pool of the balancer, as every
+				// reconcile does.
+				got = append(got, b.pool(p).urls(tc.url))
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("urls(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_endpointPool_urls_Random(t *testing.T) {
+	p := newEndpointBalancer().pool(v1alpha1.PortOrderParameters{
+		APIEndpoint:         testPrimary,
+		Endpoints:           []string{testRegional},
+		LoadBalanceStrategy: v1alpha1.LoadBalanceStrategyRandom,
+	})
+	first := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		urls := p.urls(testPrimary)
+		if len(urls) != 2 {
+			t.Fatalf("urls(...): want 2 URLs, got %v", urls)
+		}
+		first[urls[0]] = true
+	}
+	if len(first) != 2 {
+		t.Errorf("urls(...): want every endpoint to be chosen first, got %v", first)
+	}
+}
+
+func Test_endpointBalancer_position(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	b := newEndpointBalancer()
+
+	used := b.position("used", now)
+	used.Add(1)
+	b.position("idle", now)
+
+	// Positions in use are kept, idle ones are dropped.
+	if got := b.position("used", now.Add(balancerIdle/2)).Load(); got != 1 {
+		t.Errorf("b.position(...): want position 1, got %d", got)
+	}
+	b.position("other", now.Add(balancerIdle))
+	want := []string{"other", "used"}
+	got := make([]string, 0, len(b.next))
+	for k := range b.next {
+		got = append(got, k)
+	}
+	if diff := cmp.Diff(want, got, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("b.position(...): -want positions, +got positions: %s", diff)
+	}
+}
+
+func Test_external_send_Endpoints(t *testing.T) {
+	dialErr := &url.Error{Op: "Post", URL: testPrimary, Err: &net.OpError{Op: "dial", Err: errBoom}}
+	readErr := &url.Error{Op: "Post", URL: testPrimary, Err: &net.OpError{Op: "read", Err: errBoom}}
+
+	type want struct {
+		urls       []string
+		statusCode int
+	}
+
+	cases := map[string]struct {
+		method     string
+		statusCode int
+		err        error
+		want       want
+	}{
+		"Available": {
+			method:     http.MethodGet,
+			statusCode: http.StatusOK,
+			want:       want{urls: []string{testPrimary + "/order-123"}, statusCode: http.StatusOK},
+		},
+		"ReadUnavailable": {
+			method:     http.MethodGet,
+			statusCode: http.StatusServiceUnavailable,
+			want:       want{urls: []string{testPrimary + "/order-123", testRegional + "/order-123"}, statusCode: http.StatusOK},
+		},
+		"ReadFailed": {
+			method: http.MethodGet,
+			err:    readErr,
+			want:   want{urls: []string{testPrimary + "/order-123", testRegional + "/order-123"}, statusCode: http.StatusOK},
+		},
+		"WriteUnavailable": {
+			method:     http.MethodPost,
+			statusCode: http.StatusServiceUnavailable,
+			want:       want{urls: []string{testPrimary + "/order-123"}, statusCode: http.StatusServiceUnavailable},
+		},
+		"WriteFailed": {
+			method: http.MethodPost,
+			err:    readErr,
+			want:   want{urls: []string{testPrimary + "/order-123"}},
+		},
+		"WriteNotSent": {
+			method: http.MethodPost,
+			err:    dialErr,
+			want:   want{urls: []string{testPrimary + "/order-123", testRegional + "/order-123"}, statusCode: http.StatusOK},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			var urls []string
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: func(_ context.Context, _ string, u string, _ httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+					urls = append(urls, u)
+					if strings.HasPrefix(u, testPrimary) {
+						return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: tc.statusCode}}, tc.err
+					}
+					return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: http.StatusOK}}, nil
+				}},
+				logger: logging.NewNopLogger(),
+				endpoints: newEndpointBalancer().pool(v1alpha1.PortOrderParameters{
+					APIEndpoint: testPrimary,
+					Endpoints:   []string{testRegional},
+				}),
+			}
+
+			details, _ := e.send(context.Background(), tc.method, testPrimary+"/order-123", "", nil)
+			if diff := cmp.Diff(tc.want.urls, urls); diff != "" {
+				t.Errorf("e.send(...): -want URLs, +got URLs: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.statusCode, details.HttpResponse.StatusCode); diff != "" {
+				t.Errorf("e.send(...): -want status code, +got status code: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"sync"
+	"time"
+)
+
+type cachedOrder struct {
+	order    OrderResponse
+	specHash string
+	expiry   time.Time
+}
+
+// orderCache holds the orders read from the backend across reconciles for a
+// short time, keyed by order ID, so that tight reconcile loops do not read
+// the same order repeatedly. Each order is cached with the hash of the spec
+// it was read for, and read again once the spec changes, since it is likely
+// to change too. A nil cache caches nothing.
+type orderCache struct {
+	ttl time.Duration
+
+	mu     sync.Mutex
+	orders map[string]cachedOrder
+}
+
+// newOrderCache returns a cache that holds orders for the supplied duration,
+// or nil if it is not positive.
+func newOrderCache(ttl time.Duration) *orderCache {
+	if ttl <= 0 {
+		return nil
+	}
+	return &orderCache{ttl: ttl, orders: map[string]cachedOrder{}}
+}
+
+// get returns the cached order, unless it expired or was read for a spec with
+// a different hash.
+func (c *orderCache) get(id, specHash string, now time.Time) (*OrderResponse, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	o, ok := c.orders[id]
+	if !ok || now.After(o.expiry) || o.specHash != specHash {
+		delete(c.orders, id)
+		return nil, false
+	}
+	order := o.order
+	return &order, true
+}
+
+// set caches the order read for the spec with the supplied hash.
+func (c *orderCache) set(id, specHash string, order OrderResponse, now time.Time) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.orders[id] = cachedOrder{order: order, specHash: specHash, expiry: now.Add(c.ttl)}
+}
+
+// invalidate drops the supplied orders from the cache.
+func (c *orderCache) invalidate(ids ...string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, id := range ids {
+		delete(c.orders, id)
+	}
+}
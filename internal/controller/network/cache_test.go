@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+func Test_orderCache(t *testing.T) {
+	now := time.Now()
+	order := OrderResponse{OrderID: jsonIDs{testOrderID}, Status: "active"}
+
+	cases := map[string]struct {
+		cache    *orderCache
+		modify   func(c *orderCache)
+		specHash string
+		at       time.Time
+		want     *OrderResponse
+	}{
+		"Disabled": {
+			cache: newOrderCache(0),
+			at:    now,
+		},
+		"Cached": {
+			cache: newOrderCache(time.Minute),
+			at:    now.Add(30 * time.Second),
+			want:  &order,
+		},
+		"Expired": {
+			cache: newOrderCache(time.Minute),
+			at:    now.Add(2 * time.Minute),
+		},
+		"SpecChanged": {
+			cache:    newOrderCache(time.Minute),
+			specHash: "changed",
+			at:       now,
+		},
+		"Invalidated": {
+			cache: newOrderCache(time.Minute),
+			modify: func(c *orderCache) {
+				c.invalidate(testOrderID)
+			},
+			at: now,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			tc.cache.set(testOrderID, "hash", order, now)
+			if tc.modify != nil {
+				tc.modify(tc.cache)
+			}
+			hash := tc.specHash
+			if hash == "" {
+				hash = "hash"
+			}
+			got, _ := tc.cache.get(testOrderID, hash, tc.at)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Fatalf("c.get(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Observe_Cached(t *testing.T) {
+	var calls int
+	e := &external{
+		client: &MockHttpClient{MockSendRequest: func(ctx context.Context, method string, url string, body httpclient.Data, headers httpclient.Data, skip bool) (httpclient.HttpDetails, error) {
+			calls++
+			return respondWith(200, `{"orderId":"order-123","status":"active"}`)(ctx, method, url, body, headers, skip)
+		}},
+		logger: logging.NewNopLogger(),
+		orders: newOrderCache(time.Minute),
+	}
+	withOrderID := func(po *v1alpha1.PortOrder) {
+		po.Status.AtProvider.OrderID = testOrderID
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := e.Observe(context.Background(), portOrder(withOrderID)); err != nil {
+			t.Fatalf("e.Observe(...): %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("e.Observe(...) twice: want 1 request, got %d", calls)
+	}
+
+	e.orders.invalidate(testOrderID)
+	if _, err := e.Observe(context.Background(), portOrder(withOrderID)); err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("e.Observe(...) after invalidation: want 2 requests, got %d", calls)
+	}
+
+	// A changed spec bypasses the order cached for the previous spec.
+	edited := portOrder(withOrderID, func(po *v1alpha1.PortOrder) {
+		po.Spec.ForProvider.Destination = "10.0.2.0/24"
+	})
+	if _, err := e.Observe(context.Background(), edited); err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("e.Observe(...) after a spec change: want 3 requests, got %d", calls)
+	}
+	if _, err := e.Observe(context.Background(), edited); err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("e.Observe(...) twice after a spec change: want 3 requests, got %d", calls)
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+type cachedClient struct {
+	credentialsHash string
+	client          httpclient.Client
+}
+
+// clientCache holds the HTTP clients of the orders API across reconciles, so
+// that their connections are reused. Clients are keyed by where their
+// credentials come from, and rebuilt when the credentials change.
+type clientCache struct {
+	mu      sync.Mutex
+	clients map[string]cachedClient
+}
+
+func newClientCache() *clientCache {
+	return &clientCache{clients: map[string]cachedClient{}}
+}
+
+// get returns the cached client of key, unless the credentials it was built
+// with differ from the supplied ones. A new client is built and cached
+// otherwise. A nil cache builds a new client every time.
+func (c *clientCache) get(key, credentials string, build func() (httpclient.Client, error)) (httpclient.Client, error) {
+	if c == nil {
+		return build()
+	}
+
+	sum := sha256.Sum256([]byte(credentials))
+	hash := hex.EncodeToString(sum[:])
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cached, ok := c.clients[key]; ok && cached.credentialsHash == hash {
+		return cached.client, nil
+	}
+
+	h, err := build()
+	if err != nil {
+		return nil, err
+	}
+	c.clients[key] = cachedClient{credentialsHash: hash, client: h}
+	return h, nil
+}
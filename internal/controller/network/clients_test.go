@@ -0,0 +1,95 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+func Test_clientCache(t *testing.T) {
+	type get struct {
+		key         string
+		credentials string
+	}
+
+	cases := map[string]struct {
+		gets []get
+		want int
+	}{
+		"Reused": {
+			gets: []get{{"providerconfig/default|0", "a"}, {"providerconfig/default|0", "a"}},
+			want: 1,
+		},
+		"CredentialsChanged": {
+			gets: []get{{"providerconfig/default|0", "a"}, {"providerconfig/default|0", "b"}, {"providerconfig/default|0", "b"}},
+			want: 2,
+		},
+		"DifferentSources": {
+			gets: []get{{"providerconfig/default|0", "a"}, {"secret/ns/creds/key|0", "a"}},
+			want: 2,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			c := newClientCache()
+			builds := 0
+			for _, g := range tc.gets {
+				if _, err := c.get(g.key, g.credentials, func() (httpclient.Client, error) {
+					builds++
+					return &MockHttpClient{}, nil
+				}); err != nil {
+					t.Fatalf("c.get(...): %v", err)
+				}
+			}
+			if diff := cmp.Diff(tc.want, builds); diff != "" {
+				t.Fatalf("c.get(...): -want builds, +got builds: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_clientCache_Concurrent(t *testing.T) {
+	c := newClientCache()
+	var mu sync.Mutex
+	builds := 0
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = c.get("providerconfig/default|0", "a", func() (httpclient.Client, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				builds++
+				return &MockHttpClient{}, nil
+			})
+		}()
+	}
+	wg.Wait()
+
+	if builds != 1 {
+		t.Fatalf("c.get(...) concurrently: want 1 build, got %d", builds)
+	}
+}
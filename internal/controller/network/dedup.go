@@ -0,0 +1,130 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+type createdOrder struct {
+	specHash     string
+	externalName string
+	observation  v1alpha1.PortOrderObservation
+	expiry       time.Time
+}
+
+// createDeduper remembers the orders created within a short window, keyed by
+// the UID of their PortOrder, so that a create repeated by a reconcile that
+// raced the status update of the first one is not sent again. A create is
+// only suppressed if the spec is unchanged. A nil deduper suppresses nothing.
+type createDeduper struct {
+	window time.Duration
+
+	mu      sync.Mutex
+	created map[types.UID]createdOrder
+}
+
+// newCreateDeduper returns a deduper that suppresses repeated creates within
+// the supplied window, or nil if it is not positive.
+func newCreateDeduper(window time.Duration) *createDeduper {
+	if window <= 0 {
+		return nil
+	}
+	return &createDeduper{window: window, created: map[types.UID]createdOrder{}}
+}
+
+// get returns the order created for the PortOrder within the window, if its
+// spec is unchanged since.
+func (d *createDeduper) get(cr *v1alpha1.PortOrder, now time.Time) (*createdOrder, bool) {
+	if d == nil {
+		return nil, false
+	}
+	hash, ok := specHash(cr.Spec.ForProvider)
+	if !ok {
+		return nil, false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	c, ok := d.created[cr.GetUID()]
+	if !ok || now.After(c.expiry) {
+		delete(d.created, cr.GetUID())
+		return nil, false
+	}
+	if c.specHash != hash {
+		return nil, false
+	}
+	c.observation = *c.observation.DeepCopy()
+	return &c, true
+}
+
+// set records the order created for the PortOrder. Expired orders are
+// dropped, so that the orders of PortOrders that were never observed again
+// are not kept.
+func (d *createDeduper) set(cr *v1alpha1.PortOrder, now time.Time) {
+	if d == nil {
+		return
+	}
+	hash, ok := specHash(cr.Spec.ForProvider)
+	if !ok {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for uid, c := range d.created {
+		if now.After(c.expiry) {
+			delete(d.created, uid)
+		}
+	}
+	d.created[cr.GetUID()] = createdOrder{
+		specHash:     hash,
+		externalName: meta.GetExternalName(cr),
+		observation:  *cr.Status.AtProvider.DeepCopy(),
+		expiry:       now.Add(d.window),
+	}
+}
+
+// forget drops the order created for the PortOrder, so that it is created
+// again.
+func (d *createDeduper) forget(cr *v1alpha1.PortOrder) {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.created, cr.GetUID())
+}
+
+// specHash returns a hash of the spec of an order. It reports false if the
+// spec cannot be hashed.
+func specHash(p v1alpha1.PortOrderParameters) (string, bool) {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return "", false
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), true
+}
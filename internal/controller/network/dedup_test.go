@@ -0,0 +1,178 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+func Test_createDeduper(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	created := portOrder(func(po *v1alpha1.PortOrder) {
+		po.Status.AtProvider.OrderID = testOrderID
+		meta.SetExternalName(po, testOrderID)
+	})
+
+	cases := map[string]struct {
+		d      *createDeduper
+		cr     *v1alpha1.PortOrder
+		at     time.Time
+		forget bool
+		want   bool
+	}{
+		"Disabled": {
+			d:  newCreateDeduper(0),
+			cr: portOrder(),
+			at: now,
+		},
+		"WithinWindow": {
+			d:    newCreateDeduper(10 * time.Second),
+			cr:   portOrder(),
+			at:   now.Add(5 * time.Second),
+			want: true,
+		},
+		"WindowExpired": {
+			d:  newCreateDeduper(10 * time.Second),
+			cr: portOrder(),
+			at: now.Add(11 * time.Second),
+		},
+		"SpecChanged": {
+			d: newCreateDeduper(10 * time.Second),
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.Destination = "10.0.2.0/24"
+			}),
+			at: now.Add(5 * time.Second),
+		},
+		"Forgotten": {
+			d:      newCreateDeduper(10 * time.Second),
+			cr:     portOrder(),
+			at:     now.Add(5 * time.Second),
+			forget: true,
+		},
+		"OtherPortOrder": {
+			d: newCreateDeduper(10 * time.Second),
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.SetUID("5678")
+			}),
+			at: now.Add(5 * time.Second),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			tc.d.set(created, now)
+			if tc.forget {
+				tc.d.forget(created)
+			}
+			c, ok := tc.d.get(tc.cr, tc.at)
+			if diff := cmp.Diff(tc.want, ok); diff != "" {
+				t.Fatalf("d.get(...): -want ok, +got ok: %s", diff)
+			}
+			if !ok {
+				return
+			}
+			if diff := cmp.Diff(testOrderID, c.observation.OrderID); diff != "" {
+				t.Errorf("d.get(...): -want OrderID, +got OrderID: %s", diff)
+			}
+			if diff := cmp.Diff(testOrderID, c.externalName); diff != "" {
+				t.Errorf("d.get(...): -want external name, +got external name: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Create_Dedup(t *testing.T) {
+	posts := 0
+	e := &external{
+		client: &MockHttpClient{MockSendRequest: func(ctx context.Context, method string, url string, body httpclient.Data, headers httpclient.Data, skip bool) (httpclient.HttpDetails, error) {
+			posts++
+			return respondWith(201, `{"orderId":"order-123","status":"pending"}`)(ctx, method, url, body, headers, skip)
+		}},
+		logger:  logging.NewNopLogger(),
+		creates: newCreateDeduper(time.Minute),
+	}
+
+	if _, err := e.Create(context.Background(), portOrder()); err != nil {
+		t.Fatalf("e.Create(...): %v", err)
+	}
+
+	// A reconcile that raced the status update sees the PortOrder without
+	// its order.
+	cr := portOrder()
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("e.Create(...) repeated: %v", err)
+	}
+	if diff := cmp.Diff(1, posts); diff != "" {
+		t.Fatalf("e.Create(...) repeated: -want requests, +got requests: %s", diff)
+	}
+	if diff := cmp.Diff(testOrderID, cr.Status.AtProvider.OrderID); diff != "" {
+		t.Errorf("e.Create(...) repeated: -want OrderID, +got OrderID: %s", diff)
+	}
+	if diff := cmp.Diff(testOrderID, meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("e.Create(...) repeated: -want external name, +got external name: %s", diff)
+	}
+
+	// A changed spec is submitted again.
+	cr = portOrder(func(po *v1alpha1.PortOrder) {
+		po.Spec.ForProvider.Ports = append(po.Spec.ForProvider.Ports, v1alpha1.PortParameters{Type: "tcp", Number: 8443})
+	})
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("e.Create(...) with changed spec: %v", err)
+	}
+	if diff := cmp.Diff(2, posts); diff != "" {
+		t.Fatalf("e.Create(...) with changed spec: -want requests, +got requests: %s", diff)
+	}
+}
+
+func Test_createDeduper_Expired(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	d := newCreateDeduper(10 * time.Second)
+
+	d.set(portOrder(), now)
+	d.set(portOrder(func(po *v1alpha1.PortOrder) { po.SetUID("5678") }), now.Add(11*time.Second))
+	if diff := cmp.Diff(1, len(d.created)); diff != "" {
+		t.Errorf("d.set(...): -want recorded orders, +got recorded orders: %s", diff)
+	}
+}
+
+func Test_external_Delete_ForgetsCreate(t *testing.T) {
+	e := &external{
+		client:  &MockHttpClient{MockSendRequest: respondWith(204, "")},
+		logger:  logging.NewNopLogger(),
+		creates: newCreateDeduper(time.Minute),
+	}
+	cr := portOrder(func(po *v1alpha1.PortOrder) { po.Status.AtProvider.OrderID = testOrderID })
+	e.creates.set(cr, time.Now())
+
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Fatalf("e.Delete(...): %v", err)
+	}
+	if diff := cmp.Diff(0, len(e.creates.created)); diff != "" {
+		t.Errorf("e.Delete(...): -want recorded orders, +got recorded orders: %s", diff)
+	}
+}
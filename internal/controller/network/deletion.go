@@ -0,0 +1,183 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+const (
+	errCancel           = "failed to cancel order"
+	errCancelStatusCode = "cancel returned status code %d, body: %s"
+	errDeletionNotSeen  = "backend still has the order after %d deletion checks"
+	errDeleteToken      = "failed to read delete token of order"
+	errNoDeleteToken    = "order %s has no delete token at %q"
+
+	defaultMaxDeletionChecks = 10
+	defaultDeleteTokenPath   = "deleteToken"
+	defaultDeleteTokenHeader = "X-Delete-Token"
+)
+
+// cancel cancels every rule object of the order at the backend. An order the
+// backend does not find is considered cancelled.
+func (e *external) cancel(ctx context.Context, cr *v1alpha1.PortOrder) error {
+	endpoint := createEndpoint(cr.Spec.ForProvider)
+	if err := validateEndpoint(endpoint); err != nil {
+		return err
+	}
+
+	for _, id := range orderIDs(cr) {
+		if err := e.cancelOrder(ctx, cr, endpoint, id); err != nil {
+			return err
+		}
+	}
+
+	now := metav1.Now()
+	cr.Status.AtProvider.CancelRequestTime = &now
+	return nil
+}
+
+// cancelOrder cancels the order with the supplied ID.
+func (e *external) cancelOrder(ctx context.Context, cr *v1alpha1.PortOrder, endpoint, id string) error {
+	var details httpclient.HttpDetails
+	var err error
+	if isGraphQL(cr.Spec.ForProvider) {
+		details, err = e.sendGraphQL(ctx, endpoint, cancelMutation(cr.Spec.ForProvider),
+			map[string]interface{}{"id": id}, nil)
+	} else {
+		var headers map[string][]string
+		if c := cr.Spec.ForProvider.DeleteConfirmation; c != nil {
+			token, found, err := e.deleteToken(ctx, cr, *c, id)
+			if err != nil || !found {
+				return err
+			}
+			headers = map[string][]string{deleteTokenHeader(*c): {token}}
+		}
+		details, err = e.send(ctx, http.MethodDelete, orderURL(endpoint, id), "", headers)
+	}
+	if err != nil {
+		return errors.Wrap(err, errCancel)
+	}
+
+	cr.Status.AtProvider.LastResponseStatus = details.HttpResponse.StatusCode
+	switch statusAction(cr.Spec.ForProvider, false, details.HttpResponse.StatusCode) {
+	case v1alpha1.StatusCodeActionSuccess, v1alpha1.StatusCodeActionNotExists:
+	default:
+		return errors.Errorf(errCancelStatusCode, details.HttpResponse.StatusCode, details.HttpResponse.Body)
+	}
+	if isGraphQL(cr.Spec.ForProvider) {
+		if _, err := parseOrder(cr.Spec.ForProvider, details.HttpResponse.Body); err != nil {
+			return errors.Wrap(err, errCancel)
+		}
+	}
+	return nil
+}
+
+// observeDeletion observes a cancelled order. Unless the order verifies its
+// deletion, it is considered gone. Otherwise it exists until the backend
+// reports all of its rule objects as removed, for at most MaxDeletionChecks
+// observations.
+func (e *external) observeDeletion(ctx context.Context, cr *v1alpha1.PortOrder, endpoint string) (managed.ExternalObservation, error) {
+	if !cr.Spec.ForProvider.VerifyDeletion {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	limit := defaultMaxDeletionChecks
+	if cr.Spec.ForProvider.MaxDeletionChecks != nil {
+		limit = *cr.Spec.ForProvider.MaxDeletionChecks
+	}
+	if cr.Status.AtProvider.DeletionChecks >= limit {
+		return managed.ExternalObservation{}, errors.Errorf(errDeletionNotSeen, cr.Status.AtProvider.DeletionChecks)
+	}
+	cr.Status.AtProvider.DeletionChecks++
+
+	for _, id := range orderIDs(cr) {
+		order, err := e.getOrder(ctx, cr, endpoint, id)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if order == nil || hasStatus(defaultDeletedStatuses, order.Status) {
+			continue
+		}
+
+		cr.Status.AtProvider.Status = order.Status
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+	return managed.ExternalObservation{ResourceExists: false}, nil
+}
+
+// deleteToken reads the confirmation token the backend requires to cancel the
+// order with the supplied ID from the endpoint orders are read from. It
+// reports false if the backend does not find the order, which then needs no
+// cancellation. The status code of the response is handled like that of the
+// cancellation itself.
+func (e *external) deleteToken(ctx context.Context, cr *v1alpha1.PortOrder, c v1alpha1.DeleteConfirmation, id string) (string, bool, error) {
+	endpoint := observeEndpoint(cr.Spec.ForProvider)
+	if err := validateEndpoint(endpoint); err != nil {
+		return "", false, err
+	}
+
+	details, err := e.send(ctx, http.MethodGet, orderURL(endpoint, id), "", nil)
+	if err != nil {
+		return "", false, errors.Wrap(err, errDeleteToken)
+	}
+
+	cr.Status.AtProvider.LastResponseStatus = details.HttpResponse.StatusCode
+	switch statusAction(cr.Spec.ForProvider, false, details.HttpResponse.StatusCode) {
+	case v1alpha1.StatusCodeActionSuccess:
+	case v1alpha1.StatusCodeActionNotExists:
+		return "", false, nil
+	default:
+		return "", false, errors.Errorf("unexpected status code: %d, body: %s",
+			details.HttpResponse.StatusCode, details.HttpResponse.Body)
+	}
+
+	decoded := map[string]interface{}{}
+	if err := decodeJSON(details.HttpResponse.Body, &decoded); err != nil {
+		return "", false, errors.Wrap(err, errUnmarshal)
+	}
+	path := c.TokenPath
+	if path == "" {
+		path = defaultDeleteTokenPath
+	}
+	token, ok := lookupField(decoded, path)
+	if !ok || token == nil || fieldString(token) == "" {
+		return "", false, errors.Errorf(errNoDeleteToken, id, path)
+	}
+	return fieldString(token), true, nil
+}
+
+// deleteTokenHeader returns the request header the confirmation token is sent
+// in.
+func deleteTokenHeader(c v1alpha1.DeleteConfirmation) string {
+	if c.Header == "" {
+		return defaultDeleteTokenHeader
+	}
+	return c.Header
+}
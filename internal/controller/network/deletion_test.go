@@ -0,0 +1,354 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+// deleted marks the order as deleted and cancelled.
+func deleted(po *v1alpha1.PortOrder) {
+	now := v1.Now()
+	po.SetDeletionTimestamp(&now)
+	po.Status.AtProvider.OrderID = testOrderID
+	po.Status.AtProvider.CancelRequestTime = &now
+}
+
+func withVerifyDeletion(po *v1alpha1.PortOrder) {
+	po.Spec.ForProvider.VerifyDeletion = true
+}
+
+func Test_external_Delete(t *testing.T) {
+	type want struct {
+		err       error
+		requests  []string
+		cancelled bool
+	}
+
+	cases := map[string]struct {
+		http httpclient.Client
+		cr   *v1alpha1.PortOrder
+		want want
+	}{
+		"NoOrderID": {
+			cr: portOrder(),
+		},
+		"Cancel": {
+			http: &MockHttpClient{MockSendRequest: respondWith(204, "")},
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.OrderID = testOrderID
+			}),
+			want: want{
+				requests:  []string{http.MethodDelete + " https://api.example.com/orders/" + testOrderID},
+				cancelled: true,
+			},
+		},
+		"CancelEveryRule": {
+			http: &MockHttpClient{MockSendRequest: respondWith(204, "")},
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.OrderID = "rule-1"
+				po.Status.AtProvider.OrderIDs = []string{"rule-1", "rule-2"}
+			}),
+			want: want{
+				requests: []string{
+					http.MethodDelete + " https://api.example.com/orders/rule-1",
+					http.MethodDelete + " https://api.example.com/orders/rule-2",
+				},
+				cancelled: true,
+			},
+		},
+		"AlreadyGone": {
+			http: &MockHttpClient{MockSendRequest: respondWith(404, "")},
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.OrderID = testOrderID
+			}),
+			want: want{
+				requests:  []string{http.MethodDelete + " https://api.example.com/orders/" + testOrderID},
+				cancelled: true,
+			},
+		},
+		"CancelFailed": {
+			http: &MockHttpClient{MockSendRequest: respondWith(409, "locked")},
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.OrderID = testOrderID
+			}),
+			want: want{
+				err:      errors.Errorf(errCancelStatusCode, 409, "locked"),
+				requests: []string{http.MethodDelete + " https://api.example.com/orders/" + testOrderID},
+			},
+		},
+		"CancelledOnce": {
+			cr:   portOrder(deleted),
+			want: want{cancelled: true},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			var requests []string
+			e := &external{logger: logging.NewNopLogger()}
+			if m, ok := tc.http.(*MockHttpClient); ok {
+				e.client = &MockHttpClient{MockSendRequest: func(ctx context.Context, method string, url string, body httpclient.Data, headers httpclient.Data, skip bool) (httpclient.HttpDetails, error) {
+					requests = append(requests, method+" "+url)
+					return m.MockSendRequest(ctx, method, url, body, headers, skip)
+				}}
+			}
+
+			err := e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.Delete(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.requests, requests); diff != "" {
+				t.Fatalf("e.Delete(...): -want requests, +got requests: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cancelled, tc.cr.Status.AtProvider.CancelRequestTime != nil); diff != "" {
+				t.Fatalf("e.Delete(...): -want cancelled, +got cancelled: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Delete_Confirmation(t *testing.T) {
+	type want struct {
+		err       error
+		requests  []string
+		token     []string
+		cancelled bool
+	}
+
+	cases := map[string]struct {
+		confirmation v1alpha1.DeleteConfirmation
+		actions      []v1alpha1.StatusCodeAction
+		get          MockSendRequestFn
+		want         want
+	}{
+		"DefaultToken": {
+			get: respondWith(200, `{"orderId":"order-123","status":"active","deleteToken":"tok-1"}`),
+			want: want{
+				requests:  []string{http.MethodGet, http.MethodDelete},
+				token:     []string{"tok-1"},
+				cancelled: true,
+			},
+		},
+		"CustomToken": {
+			confirmation: v1alpha1.DeleteConfirmation{TokenPath: "links.cancel.token", Header: "X-Confirm"},
+			get:          respondWith(200, `{"orderId":"order-123","links":{"cancel":{"token":42}}}`),
+			want: want{
+				requests:  []string{http.MethodGet, http.MethodDelete},
+				token:     []string{"42"},
+				cancelled: true,
+			},
+		},
+		"AlreadyGone": {
+			get: respondWith(404, ""),
+			want: want{
+				requests:  []string{http.MethodGet},
+				cancelled: true,
+			},
+		},
+		"AlreadyGoneCustomCode": {
+			actions: []v1alpha1.StatusCodeAction{{Codes: "410", Action: v1alpha1.StatusCodeActionNotExists}},
+			get:     respondWith(410, ""),
+			want: want{
+				requests:  []string{http.MethodGet},
+				cancelled: true,
+			},
+		},
+		"NotFoundRetried": {
+			actions: []v1alpha1.StatusCodeAction{{Codes: "404", Action: v1alpha1.StatusCodeActionRetry}},
+			get:     respondWith(404, "not yet replicated"),
+			want: want{
+				err:      errors.New("unexpected status code: 404, body: not yet replicated"),
+				requests: []string{http.MethodGet},
+			},
+		},
+		"TokenMissing": {
+			get: respondWith(200, `{"orderId":"order-123","status":"active"}`),
+			want: want{
+				err:      errors.Errorf(errNoDeleteToken, testOrderID, "deleteToken"),
+				requests: []string{http.MethodGet},
+			},
+		},
+		"GetFailed": {
+			get: respondWith(500, "oops"),
+			want: want{
+				err:      errors.New("unexpected status code: 500, body: oops"),
+				requests: []string{http.MethodGet},
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: func(ctx context.Context, method string, url string, body httpclient.Data, headers httpclient.Data, skip bool) (httpclient.HttpDetails, error) {
+					got.requests = append(got.requests, method)
+					if method == http.MethodGet {
+						return tc.get(ctx, method, url, body, headers, skip)
+					}
+					got.token = headers.Decrypted.(map[string][]string)[deleteTokenHeader(tc.confirmation)]
+					return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 204}}, nil
+				}},
+				logger: logging.NewNopLogger(),
+			}
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.DeleteConfirmation = &tc.confirmation
+				po.Spec.ForProvider.StatusCodeActions = tc.actions
+				po.Status.AtProvider.OrderID = testOrderID
+			})
+
+			got.err = e.Delete(context.Background(), cr)
+			got.cancelled = cr.Status.AtProvider.CancelRequestTime != nil
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors(), cmp.AllowUnexported(want{})); diff != "" {
+				t.Fatalf("e.Delete(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_deleteToken_ObserveEndpoint(t *testing.T) {
+	var urls []string
+	e := &external{
+		client: &MockHttpClient{MockSendRequest: func(_ context.Context, method string, url string, _ httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+			urls = append(urls, method+" "+url)
+			if method == http.MethodGet {
+				return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 200, Body: `{"deleteToken":"tok-1"}`}}, nil
+			}
+			return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 204}}, nil
+		}},
+		logger: logging.NewNopLogger(),
+	}
+	cr := portOrder(func(po *v1alpha1.PortOrder) {
+		po.Spec.ForProvider.CreateEndpoint = "https://api.example.com/submit"
+		po.Spec.ForProvider.ObserveEndpoint = "https://api.example.com/orders"
+		po.Spec.ForProvider.DeleteConfirmation = &v1alpha1.DeleteConfirmation{}
+		po.Status.AtProvider.OrderID = testOrderID
+	})
+
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Fatalf("e.Delete(...): %v", err)
+	}
+	want := []string{
+		http.MethodGet + " https://api.example.com/orders/" + testOrderID,
+		http.MethodDelete + " https://api.example.com/submit/" + testOrderID,
+	}
+	if diff := cmp.Diff(want, urls); diff != "" {
+		t.Errorf("e.Delete(...): -want requests, +got requests: %s", diff)
+	}
+}
+
+func Test_external_Observe_Deletion(t *testing.T) {
+	type want struct {
+		obs    managed.ExternalObservation
+		err    error
+		checks int
+	}
+
+	cases := map[string]struct {
+		http httpclient.Client
+		cr   *v1alpha1.PortOrder
+		want want
+	}{
+		"NotCancelledYet": {
+			http: &MockHttpClient{MockSendRequest: respondWith(200, `{"orderId":"order-123","status":"active"}`)},
+			cr: portOrder(deleted, func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.CancelRequestTime = nil
+			}),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"NotVerified": {
+			cr:   portOrder(deleted),
+			want: want{obs: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"StillActive": {
+			http: &MockHttpClient{MockSendRequest: respondWith(200, `{"orderId":"order-123","status":"cancelling"}`)},
+			cr:   portOrder(deleted, withVerifyDeletion),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, checks: 1},
+		},
+		"ReportedCancelled": {
+			http: &MockHttpClient{MockSendRequest: respondWith(200, `{"orderId":"order-123","status":"Cancelled"}`)},
+			cr:   portOrder(deleted, withVerifyDeletion),
+			want: want{obs: managed.ExternalObservation{ResourceExists: false}, checks: 1},
+		},
+		"NotFound": {
+			http: &MockHttpClient{MockSendRequest: respondWith(404, "")},
+			cr:   portOrder(deleted, withVerifyDeletion),
+			want: want{obs: managed.ExternalObservation{ResourceExists: false}, checks: 1},
+		},
+		"RuleStillActive": {
+			http: &MockHttpClient{MockSendRequest: func(ctx context.Context, method string, url string, body httpclient.Data, headers httpclient.Data, skip bool) (httpclient.HttpDetails, error) {
+				if strings.HasSuffix(url, "/rule-2") {
+					return respondWith(200, `{"orderId":"rule-2","status":"cancelling"}`)(ctx, method, url, body, headers, skip)
+				}
+				return respondWith(404, "")(ctx, method, url, body, headers, skip)
+			}},
+			cr: portOrder(deleted, withVerifyDeletion, func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.OrderIDs = []string{testOrderID, "rule-2"}
+			}),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, checks: 1},
+		},
+		"ChecksExhausted": {
+			cr: portOrder(deleted, withVerifyDeletion, func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.MaxDeletionChecks = ptr.To(3)
+				po.Status.AtProvider.DeletionChecks = 3
+			}),
+			want: want{err: errors.Errorf(errDeletionNotSeen, 3), checks: 3},
+		},
+		"NoOrderIDNotSubmitted": {
+			cr: portOrder(deleted, func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.OrderID = ""
+				po.Spec.ForProvider.DependsOn = []string{"never-ready"}
+			}),
+			want: want{obs: managed.ExternalObservation{ResourceExists: false}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.http, logger: logging.NewNopLogger()}
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.Observe(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Fatalf("e.Observe(...): -want, +got: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.checks, tc.cr.Status.AtProvider.DeletionChecks); diff != "" {
+				t.Fatalf("e.Observe(...): -want DeletionChecks, +got DeletionChecks: %s", diff)
+			}
+		})
+	}
+}
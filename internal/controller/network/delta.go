@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+// readsDeltas reports whether the order is read as the changes since its
+// previous read. Orders of several rule objects are always read in full.
+func readsDeltas(cr *v1alpha1.PortOrder) bool {
+	p := cr.Spec.ForProvider
+	return p.DeltaCursorParameter != "" && !isGraphQL(p) && len(orderIDs(cr)) == 1
+}
+
+// deltaCursor returns the cursor the next read of the order is sent with, or
+// an empty string if it is read in full.
+func deltaCursor(cr *v1alpha1.PortOrder) string {
+	if !readsDeltas(cr) {
+		return ""
+	}
+	return cr.Status.AtProvider.ObserveCursor
+}
+
+// withCursor adds the cursor to the query of the order URL, unless it is
+// empty.
+func withCursor(p v1alpha1.PortOrderParameters, orderURL, cursor string) string {
+	if cursor == "" {
+		return orderURL
+	}
+	sep := "?"
+	if strings.Contains(orderURL, "?") {
+		sep = "&"
+	}
+	return orderURL + sep + url.QueryEscape(p.DeltaCursorParameter) + "=" + url.QueryEscape(cursor)
+}
+
+// observedOrder returns the order as last observed, as recorded in the status
+// of the PortOrder.
+func observedOrder(cr *v1alpha1.PortOrder) *OrderResponse {
+	o := cr.Status.AtProvider
+	order := &OrderResponse{
+		OrderID:         jsonIDs{o.OrderID},
+		Status:          o.Status,
+		Source:          o.Source,
+		Destination:     o.Destination,
+		RuleIDs:         append(jsonIDs(nil), o.RuleIDs...),
+		AffectedDevices: o.AffectedDevices,
+		Reason:          o.StatusReason,
+		NextAction:      o.NextAction,
+		Zone:            o.Zone,
+		Cursor:          o.ObserveCursor,
+	}
+	// Copies are taken, since decoding into the order writes through its
+	// pointers.
+	if o.PollAfterSeconds != nil {
+		order.PollAfterSeconds = ptr.To(*o.PollAfterSeconds)
+	}
+	if o.AppliedPercentage != nil {
+		order.AppliedPercentage = ptr.To(*o.AppliedPercentage)
+	}
+	for k, v := range o.BackendTags {
+		if order.Tags == nil {
+			order.Tags = map[string]interface{}{}
+		}
+		order.Tags[k] = v
+	}
+	if o.LastModifiedByBackend != nil {
+		order.LastModified = o.LastModifiedByBackend.Format(time.RFC3339)
+	}
+	return order
+}
+
+// mergeOrder applies the changes in a response body to the order as last
+// observed. Fields absent from the body did not change.
+func mergeOrder(cr *v1alpha1.PortOrder, body string) (*OrderResponse, error) {
+	order := observedOrder(cr)
+	if strings.TrimSpace(body) == "" {
+		return order, nil
+	}
+	if err := decodeOrder(cr.Spec.ForProvider, body, order); err != nil {
+		return nil, err
+	}
+	cr.Status.AtProvider.ObserveCursor = order.Cursor
+	return order, nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+func Test_external_Observe_Delta(t *testing.T) {
+	type response struct {
+		code int
+		body string
+	}
+	type want struct {
+		urls    []string
+		status  string
+		devices int
+		cursor  string
+	}
+
+	withCursor := func(cursor string) portOrderModifier {
+		return func(po *v1alpha1.PortOrder) {
+			po.Spec.ForProvider.DeltaCursorParameter = "since"
+			po.Status.AtProvider.OrderID = testOrderID
+			po.Status.AtProvider.Status = "pending"
+			po.Status.AtProvider.AffectedDevices = 3
+			po.Status.AtProvider.ObserveCursor = cursor
+		}
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.PortOrder
+		responses []response
+		want      want
+	}{
+		"FirstRead": {
+			cr:        portOrder(withCursor("")),
+			responses: []response{{200, `{"orderId":"order-123","status":"pending","affectedDevices":3,"cursor":"c1"}`}},
+			want: want{
+				urls:    []string{testEndpoint + "/order-123"},
+				status:  "pending",
+				devices: 3,
+				cursor:  "c1",
+			},
+		},
+		"Changes": {
+			cr:        portOrder(withCursor("c1")),
+			responses: []response{{200, `{"status":"active","cursor":"c2"}`}},
+			want: want{
+				urls:    []string{testEndpoint + "/order-123?since=c1"},
+				status:  "active",
+				devices: 3,
+				cursor:  "c2",
+			},
+		},
+		"NotModified": {
+			cr:        portOrder(withCursor("c1")),
+			responses: []response{{304, ""}},
+			want: want{
+				urls:    []string{testEndpoint + "/order-123?since=c1"},
+				status:  "pending",
+				devices: 3,
+				cursor:  "c1",
+			},
+		},
+		"CursorExpired": {
+			cr: portOrder(withCursor("c1")),
+			responses: []response{
+				{410, `{"message":"cursor expired"}`},
+				{200, `{"orderId":"order-123","status":"active","affectedDevices":4,"cursor":"c9"}`},
+			},
+			want: want{
+				urls:    []string{testEndpoint + "/order-123?since=c1", testEndpoint + "/order-123"},
+				status:  "active",
+				devices: 4,
+				cursor:  "c9",
+			},
+		},
+		"DeltasNotSupported": {
+			cr:        portOrder(withCursor("c1")),
+			responses: []response{{400, `{"message":"unknown parameter since"}`}, {200, `{"orderId":"order-123","status":"active"}`}},
+			want: want{
+				urls:   []string{testEndpoint + "/order-123?since=c1", testEndpoint + "/order-123"},
+				status: "active",
+			},
+		},
+		"SeveralRuleObjects": {
+			cr: portOrder(withCursor("c1"), func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.OrderIDs = []string{testOrderID, "order-456"}
+			}),
+			responses: []response{{200, `{"orderId":"order-123","status":"active","cursor":"c2"}`}},
+			want: want{
+				urls:   []string{testEndpoint + "/order-123", testEndpoint + "/order-456"},
+				status: "active",
+				cursor: "c1",
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			var urls []string
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: func(_ context.Context, _ string, url string, _ httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+					r := tc.responses[len(tc.responses)-1]
+					if len(urls) < len(tc.responses) {
+						r = tc.responses[len(urls)]
+					}
+					urls = append(urls, url)
+					return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: r.code, Body: r.body}}, nil
+				}},
+				logger: logging.NewNopLogger(),
+			}
+
+			if _, err := e.Observe(context.Background(), tc.cr); err != nil {
+				t.Fatalf("e.Observe(...): %v", err)
+			}
+			o := tc.cr.Status.AtProvider
+			if diff := cmp.Diff(tc.want.urls, urls); diff != "" {
+				t.Errorf("e.Observe(...): -want URLs, +got URLs: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, o.Status); diff != "" {
+				t.Errorf("e.Observe(...): -want Status, +got Status: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.devices, o.AffectedDevices); diff != "" {
+				t.Errorf("e.Observe(...): -want AffectedDevices, +got AffectedDevices: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cursor, o.ObserveCursor); diff != "" {
+				t.Errorf("e.Observe(...): -want ObserveCursor, +got ObserveCursor: %s", diff)
+			}
+		})
+	}
+}
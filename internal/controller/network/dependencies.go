@@ -0,0 +1,96 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+const (
+	errGetDependency   = "cannot get PortOrder dependency %s"
+	errDependencyCycle = "PortOrder dependency cycle: %s"
+)
+
+// pendingDependencies returns the names of the orders the supplied order
+// depends on that have not succeeded yet. Orders that do not exist yet are
+// pending. An error is returned if the dependencies form a cycle.
+func pendingDependencies(ctx context.Context, kube client.Client, cr *v1alpha1.PortOrder) ([]string, error) {
+	if err := checkDependencyCycle(ctx, kube, cr, []string{cr.GetName()}, map[string]bool{}); err != nil {
+		return nil, err
+	}
+
+	var pending []string
+	for _, name := range cr.Spec.ForProvider.DependsOn {
+		dep, err := getDependency(ctx, kube, name)
+		if err != nil {
+			return nil, err
+		}
+		if dep == nil || !isReady(dep) {
+			pending = append(pending, name)
+		}
+	}
+	return pending, nil
+}
+
+// checkDependencyCycle walks the dependency graph depth-first and fails if it
+// leads back to an order already on the current path. Orders whose
+// dependencies were already fully explored without finding a cycle are
+// recorded in visited and not fetched again.
+func checkDependencyCycle(ctx context.Context, kube client.Client, cr *v1alpha1.PortOrder, path []string, visited map[string]bool) error {
+	for _, name := range cr.Spec.ForProvider.DependsOn {
+		for _, p := range path {
+			if p == name {
+				return errors.Errorf(errDependencyCycle, strings.Join(append(path, name), " -> "))
+			}
+		}
+		if visited[name] {
+			continue
+		}
+
+		dep, err := getDependency(ctx, kube, name)
+		if err != nil {
+			return err
+		}
+		if dep != nil {
+			if err := checkDependencyCycle(ctx, kube, dep, append(path, name), visited); err != nil {
+				return err
+			}
+		}
+		visited[name] = true
+	}
+	return nil
+}
+
+// getDependency returns the named PortOrder, or nil if it does not exist.
+func getDependency(ctx context.Context, kube client.Client, name string) (*v1alpha1.PortOrder, error) {
+	dep := &v1alpha1.PortOrder{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: name}, dep); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, errGetDependency, name)
+	}
+	return dep, nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+// withOrders returns a MockGet that serves the supplied PortOrders by name.
+func withOrders(orders ...*v1alpha1.PortOrder) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		for _, o := range orders {
+			if o.GetName() == key.Name {
+				o.DeepCopyInto(obj.(*v1alpha1.PortOrder))
+				return nil
+			}
+		}
+		return kerrors.NewNotFound(v1alpha1.SchemeGroupVersion.WithResource("portorders").GroupResource(), key.Name)
+	}
+}
+
+func namedOrder(name, status string, dependsOn ...string) *v1alpha1.PortOrder {
+	return portOrder(func(po *v1alpha1.PortOrder) {
+		po.SetName(name)
+		po.Status.AtProvider.Status = status
+		po.Spec.ForProvider.DependsOn = dependsOn
+	})
+}
+
+func Test_pendingDependencies(t *testing.T) {
+	type want struct {
+		pending []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		cr     *v1alpha1.PortOrder
+		orders []*v1alpha1.PortOrder
+		want   want
+	}{
+		"AllReady": {
+			cr:     namedOrder("a", "", "b", "c"),
+			orders: []*v1alpha1.PortOrder{namedOrder("b", "active"), namedOrder("c", "Complete")},
+		},
+		"SomePending": {
+			cr:     namedOrder("a", "", "b", "c"),
+			orders: []*v1alpha1.PortOrder{namedOrder("b", "active"), namedOrder("c", "pending")},
+			want:   want{pending: []string{"c"}},
+		},
+		"MissingIsPending": {
+			cr:   namedOrder("a", "", "b"),
+			want: want{pending: []string{"b"}},
+		},
+		"DirectCycle": {
+			cr:     namedOrder("a", "", "b"),
+			orders: []*v1alpha1.PortOrder{namedOrder("b", "active", "a")},
+			want:   want{err: errors.Errorf(errDependencyCycle, "a -> b -> a")},
+		},
+		"IndirectCycle": {
+			cr:     namedOrder("a", "", "b"),
+			orders: []*v1alpha1.PortOrder{namedOrder("b", "", "c"), namedOrder("c", "", "b")},
+			want:   want{err: errors.Errorf(errDependencyCycle, "a -> b -> c -> b")},
+		},
+		"SelfDependency": {
+			cr:   namedOrder("a", "", "a"),
+			want: want{err: errors.Errorf(errDependencyCycle, "a -> a")},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			kube := &test.MockClient{MockGet: withOrders(tc.orders...)}
+			pending, err := pendingDependencies(context.Background(), kube, tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("pendingDependencies(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.pending, pending); diff != "" {
+				t.Fatalf("pendingDependencies(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_checkDependencyCycle_Diamond(t *testing.T) {
+	// Every order of each layer depends on every order of the next one.
+	orders := []*v1alpha1.PortOrder{
+		namedOrder("b1", "", "c"), namedOrder("b2", "", "c"),
+		namedOrder("c", "", "d1", "d2"),
+		namedOrder("d1", "", "e"), namedOrder("d2", "", "e"),
+		namedOrder("e", ""),
+	}
+	gets := 0
+	get := withOrders(orders...)
+	kube := &test.MockClient{MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+		gets++
+		return get(ctx, key, obj)
+	}}
+
+	err := checkDependencyCycle(context.Background(), kube, namedOrder("a", "", "b1", "b2"), []string{"a"}, map[string]bool{})
+	if err != nil {
+		t.Fatalf("checkDependencyCycle(...): %v", err)
+	}
+	if diff := cmp.Diff(len(orders), gets); diff != "" {
+		t.Errorf("checkDependencyCycle(...): -want GETs, +got GETs: %s", diff)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+// deprecation is the deprecation of an endpoint the backend announced in the
+// Deprecation or Sunset header of a response, as in RFC 9745 and RFC 8594.
+type deprecation struct {
+	// endpoint is the URL of the request, without its query.
+	endpoint string
+	// sunset is when the endpoint is retired, if announced.
+	sunset *time.Time
+}
+
+// responseDeprecation returns the deprecation the response announces, if
+// any. A Sunset header without a Deprecation header announces a deprecation
+// too.
+func responseDeprecation(url string, resp httpclient.HttpResponse) (*deprecation, bool) {
+	deprecated := headerValue(resp.Headers, "Deprecation")
+	sunset := headerValue(resp.Headers, "Sunset")
+	if (deprecated == "" || strings.EqualFold(deprecated, "false")) && sunset == "" {
+		return nil, false
+	}
+	d := &deprecation{endpoint: withoutQuery(url)}
+	if t, err := http.ParseTime(sunset); err == nil {
+		d.sunset = &t
+	}
+	return d, true
+}
+
+// recordDeprecation records whether the backend announced the endpoints of
+// the order as deprecated in the responses of the operation, logging a
+// warning when it first did. It is left as it is if no response was received.
+func (e *external) recordDeprecation(cr *v1alpha1.PortOrder) {
+	if !e.responded {
+		return
+	}
+	if e.deprecation == nil {
+		cr.Status.AtProvider.SunsetTime = nil
+		if cr.GetCondition(v1alpha1.TypeBackendDeprecated).Status == corev1.ConditionTrue {
+			cr.SetConditions(v1alpha1.BackendNotDeprecated())
+		}
+		return
+	}
+
+	d := e.deprecation
+	cr.Status.AtProvider.SunsetTime = nil
+	sunset := "unknown"
+	if d.sunset != nil {
+		t := metav1.NewTime(*d.sunset)
+		cr.Status.AtProvider.SunsetTime = &t
+		sunset = d.sunset.UTC().Format(time.RFC3339)
+	}
+	if cr.GetCondition(v1alpha1.TypeBackendDeprecated).Status != corev1.ConditionTrue {
+		e.logger.Info("Warning: orders API endpoint is deprecated, migrate to its successor before it is retired", "endpoint", d.endpoint, "sunset", sunset)
+	}
+	cr.SetConditions(v1alpha1.BackendDeprecated(d.endpoint, d.sunset))
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+func Test_external_Observe_Deprecation(t *testing.T) {
+	sunset := time.Date(2026, 6, 30, 23, 59, 59, 0, time.UTC)
+	endpoint := testEndpoint + "/" + testOrderID
+
+	type want struct {
+		condition xpv1.Condition
+		sunset    *metav1.Time
+	}
+
+	cases := map[string]struct {
+		headers map[string][]string
+		cr      *v1alpha1.PortOrder
+		want    want
+	}{
+		"NotDeprecated": {
+			cr:   portOrder(),
+			want: want{condition: xpv1.Condition{Type: v1alpha1.TypeBackendDeprecated, Status: "Unknown"}},
+		},
+		"Deprecated": {
+			headers: map[string][]string{"Deprecation": {"@1688169599"}},
+			cr:      portOrder(),
+			want:    want{condition: v1alpha1.BackendDeprecated(endpoint, nil)},
+		},
+		"DeprecatedWithSunset": {
+			headers: map[string][]string{"Deprecation": {"true"}, "Sunset": {"Tue, 30 Jun 2026 23:59:59 GMT"}},
+			cr:      portOrder(),
+			want: want{
+				condition: v1alpha1.BackendDeprecated(endpoint, &sunset),
+				sunset:    &metav1.Time{Time: sunset},
+			},
+		},
+		"SunsetOnly": {
+			headers: map[string][]string{"sunset": {"Tue, 30 Jun 2026 23:59:59 GMT"}},
+			cr:      portOrder(),
+			want: want{
+				condition: v1alpha1.BackendDeprecated(endpoint, &sunset),
+				sunset:    &metav1.Time{Time: sunset},
+			},
+		},
+		"NoLongerDeprecated": {
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.SetConditions(v1alpha1.BackendDeprecated(endpoint, &sunset))
+				po.Status.AtProvider.SunsetTime = &metav1.Time{Time: sunset}
+			}),
+			want: want{condition: v1alpha1.BackendNotDeprecated()},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			tc.cr.Status.AtProvider.OrderID = testOrderID
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: func(_ context.Context, _ string, _ string, _ httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+					return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{
+						StatusCode: 200,
+						Body:       `{"orderId":"order-123","status":"active"}`,
+						Headers:    tc.headers,
+					}}, nil
+				}},
+				logger: logging.NewNopLogger(),
+			}
+
+			if _, err := e.Observe(context.Background(), tc.cr); err != nil {
+				t.Fatalf("e.Observe(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.condition, tc.cr.GetCondition(v1alpha1.TypeBackendDeprecated), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("e.Observe(...): -want BackendDeprecated condition, +got BackendDeprecated condition: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.sunset, tc.cr.Status.AtProvider.SunsetTime); diff != "" {
+				t.Errorf("e.Observe(...): -want SunsetTime, +got SunsetTime: %s", diff)
+			}
+		})
+	}
+}
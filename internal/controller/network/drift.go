@@ -0,0 +1,248 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	"github.com/crossplane-contrib/provider-http/internal/utils"
+)
+
+const (
+	errAmend              = "cannot amend order source and destination"
+	errAmendPorts         = "cannot amend order ports"
+	errAmendStatusCode    = "amending order returned status code %d, body: %s"
+	errEndpointsImmutable = "backend does not allow changing the source or destination of order %s, delete and recreate the PortOrder instead"
+	errPortsImmutable     = "backend does not allow changing the ports of order %s, delete and recreate the PortOrder instead"
+)
+
+// reasonExternalChange is the reason of the event recorded when the backend
+// reports other ports for an order than desired.
+const reasonExternalChange event.Reason = "ExternalChangeDetected"
+
+// endpointsUpdate is the request body that amends the source and destination
+// of an existing order.
+type endpointsUpdate struct {
+	Order struct {
+		Source      string `json:"source"`
+		Destination string `json:"destination"`
+	} `json:"order"`
+}
+
+// endpointsDrifted reports whether the backend reports a different source or
+// destination for the order than desired. Fields the backend does not report
+// are not compared.
+func endpointsDrifted(cr *v1alpha1.PortOrder) bool {
+	source, destination := desiredEndpoints(cr.Spec.ForProvider)
+	o := cr.Status.AtProvider
+	return (o.Source != "" && o.Source != source) ||
+		(o.Destination != "" && o.Destination != destination)
+}
+
+// amendEndpoints requests the desired source and destination for every rule
+// object of an existing order. Backends that do not allow changing them fail
+// with a clear error, since retrying cannot succeed.
+func (e *external) amendEndpoints(ctx context.Context, cr *v1alpha1.PortOrder) error {
+	if isGraphQL(cr.Spec.ForProvider) {
+		return errors.Errorf(errEndpointsImmutable, cr.Status.AtProvider.OrderID)
+	}
+
+	endpoint := createEndpoint(cr.Spec.ForProvider)
+	if err := validateEndpoint(endpoint); err != nil {
+		return err
+	}
+
+	var req endpointsUpdate
+	req.Order.Source, req.Order.Destination = e.orderEndpoints(cr)
+	body, err := json.Marshal(req)
+	if err != nil {
+		return errors.Wrap(err, errMarshal)
+	}
+
+	for _, id := range orderIDs(cr) {
+		details, err := e.patchOrder(ctx, cr, endpoint, id, string(body))
+		if err != nil {
+			return errors.Wrap(err, errAmend)
+		}
+
+		cr.Status.AtProvider.LastResponseStatus = details.HttpResponse.StatusCode
+		switch code := details.HttpResponse.StatusCode; {
+		case code == http.StatusMethodNotAllowed || code == http.StatusConflict || code == http.StatusUnprocessableEntity:
+			return errors.Errorf(errEndpointsImmutable, id)
+		case !utils.IsHTTPSuccess(code):
+			return errors.Errorf(errAmendStatusCode, code, details.HttpResponse.Body)
+		}
+	}
+
+	cr.Status.AtProvider.Source = req.Order.Source
+	cr.Status.AtProvider.Destination = req.Order.Destination
+	return nil
+}
+
+// portsUpdate is the request body that amends the ports of an existing
+// order.
+type portsUpdate struct {
+	Order struct {
+		Ports []PortEntry `json:"ports"`
+	} `json:"order"`
+}
+
+// portString returns the port in the form protocol/number, e.g. "tcp/443",
+// or just the protocol if it has no port number.
+func portString(protocol string, number *int) string {
+	if number == nil {
+		return protocol
+	}
+	return fmt.Sprintf("%s/%d", protocol, *number)
+}
+
+// specPortString returns the port of a PortOrder in the form of portString.
+func specPortString(p v1alpha1.PortParameters) string {
+	if portType(p) == v1alpha1.PortTypeICMP {
+		return portString(v1alpha1.PortTypeICMP, nil)
+	}
+	return portString(portType(p), &p.Number)
+}
+
+// desiredPorts returns the desired ports of the order, sorted.
+func desiredPorts(p v1alpha1.PortOrderParameters) []string {
+	ports := make([]string, 0, len(p.Ports))
+	for _, port := range p.Ports {
+		ports = append(ports, specPortString(port))
+	}
+	slices.Sort(ports)
+	return slices.Compact(ports)
+}
+
+// observedPorts returns the ports the backend reports in the same form as
+// desiredPorts, with ranges in the form protocol/from-to. Protocols may be
+// reported by name or IP protocol number.
+func observedPorts(entries []PortEntry) []string {
+	ports := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		protocol := strings.ToLower(fieldString(entry.Protocol))
+		for name, n := range protocolNumbers {
+			if protocol == fmt.Sprint(n) {
+				protocol = name
+			}
+		}
+		if entry.Port != nil && entry.EndPort != nil && *entry.EndPort > *entry.Port {
+			ports = append(ports, fmt.Sprintf("%s/%d-%d", protocol, *entry.Port, *entry.EndPort))
+			continue
+		}
+		ports = append(ports, portString(protocol, entry.Port))
+	}
+	slices.Sort(ports)
+	return slices.Compact(ports)
+}
+
+// portsDrifted reports whether the backend reports other ports for the order
+// than desired. Ports are not compared unless the backend reports them.
+func portsDrifted(cr *v1alpha1.PortOrder) bool {
+	observed := cr.Status.AtProvider.ObservedPorts
+	if len(observed) == 0 {
+		return false
+	}
+	missing, extra := portMismatch(observed, desiredPorts(cr.Spec.ForProvider))
+	return len(missing) > 0 || len(extra) > 0
+}
+
+// reassertPorts reports whether ports changed at the backend are to be
+// changed back to the desired ones.
+func reassertPorts(cr *v1alpha1.PortOrder) bool {
+	return cr.Spec.ForProvider.ExternalChangePolicy == v1alpha1.ExternalChangePolicyReassert && portsDrifted(cr)
+}
+
+// recordPorts records the ports the backend reports for the order, if any,
+// and whether they were changed outside of the PortOrder. Unless they are
+// reasserted, a warning event is recorded when a change is first detected.
+func (e *external) recordPorts(cr *v1alpha1.PortOrder, entries []PortEntry) {
+	if len(entries) == 0 {
+		return
+	}
+	cr.Status.AtProvider.ObservedPorts = observedPorts(entries)
+	recordPortMismatch(cr)
+	if !portsDrifted(cr) {
+		cr.SetConditions(v1alpha1.NoExternalChange())
+		return
+	}
+
+	observed, desired := cr.Status.AtProvider.ObservedPorts, desiredPorts(cr.Spec.ForProvider)
+	detected := cr.GetCondition(v1alpha1.TypeExternalChangeDetected).Status == corev1.ConditionTrue
+	cr.SetConditions(v1alpha1.ExternalChangeDetected(observed, desired))
+	if detected || e.recorder == nil || cr.Spec.ForProvider.ExternalChangePolicy == v1alpha1.ExternalChangePolicyReassert {
+		return
+	}
+	e.recorder.Event(cr, event.Warning(reasonExternalChange, errors.Errorf(
+		"backend has ports %s rather than %s, which are left as they are",
+		strings.Join(observed, ", "), strings.Join(desired, ", "))))
+}
+
+// amendPorts requests the desired ports for every rule object of an existing
+// order. Backends that do not allow changing them fail with a clear error,
+// since retrying cannot succeed.
+func (e *external) amendPorts(ctx context.Context, cr *v1alpha1.PortOrder) error {
+	if isGraphQL(cr.Spec.ForProvider) {
+		return errors.Errorf(errPortsImmutable, cr.Status.AtProvider.OrderID)
+	}
+
+	endpoint := createEndpoint(cr.Spec.ForProvider)
+	if err := validateEndpoint(endpoint); err != nil {
+		return err
+	}
+
+	var req portsUpdate
+	ports, err := e.convertPorts(cr.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+	req.Order.Ports = ports
+	body, err := json.Marshal(req)
+	if err != nil {
+		return errors.Wrap(err, errMarshal)
+	}
+
+	for _, id := range orderIDs(cr) {
+		details, err := e.patchOrder(ctx, cr, endpoint, id, string(body))
+		if err != nil {
+			return errors.Wrap(err, errAmendPorts)
+		}
+
+		cr.Status.AtProvider.LastResponseStatus = details.HttpResponse.StatusCode
+		switch code := details.HttpResponse.StatusCode; {
+		case code == http.StatusMethodNotAllowed || code == http.StatusConflict || code == http.StatusUnprocessableEntity:
+			return errors.Errorf(errPortsImmutable, id)
+		case !utils.IsHTTPSuccess(code):
+			return errors.Errorf(errAmendStatusCode, code, details.HttpResponse.Body)
+		}
+	}
+
+	cr.Status.AtProvider.ObservedPorts = desiredPorts(cr.Spec.ForProvider)
+	cr.SetConditions(v1alpha1.NoExternalChange(), v1alpha1.PortsMatch())
+	return nil
+}
@@ -0,0 +1,389 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+func withObservedEndpoints(source, destination string) func(*v1alpha1.PortOrder) {
+	return func(po *v1alpha1.PortOrder) {
+		po.Status.AtProvider.OrderID = testOrderID
+		po.Status.AtProvider.Source = source
+		po.Status.AtProvider.Destination = destination
+	}
+}
+
+func Test_endpointsDrifted(t *testing.T) {
+	cases := map[string]struct {
+		cr   *v1alpha1.PortOrder
+		want bool
+	}{
+		"NotReported": {
+			cr: portOrder(withObservedEndpoints("", "")),
+		},
+		"InSync": {
+			cr: portOrder(withObservedEndpoints("10.0.0.0/24", "10.0.1.0/24")),
+		},
+		"NormalizedInSync": {
+			cr: portOrder(withObservedEndpoints("10.0.0.0/24", "10.0.1.0/24"), func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.Source = "10.0.0.5/24"
+			}),
+		},
+		"SourceChanged": {
+			cr:   portOrder(withObservedEndpoints("10.9.0.0/24", "10.0.1.0/24")),
+			want: true,
+		},
+		"DestinationChanged": {
+			cr:   portOrder(withObservedEndpoints("", "10.9.1.0/24")),
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, endpointsDrifted(tc.cr)); diff != "" {
+				t.Fatalf("endpointsDrifted(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Observe_Drift(t *testing.T) {
+	e := &external{
+		client: &MockHttpClient{MockSendRequest: respondWith(200, `{"orderId":"order-123","status":"active","source":"10.9.0.0/24","destination":"10.0.1.0/24"}`)},
+		logger: logging.NewNopLogger(),
+	}
+	cr := portOrder(withObservedEndpoints("", ""))
+
+	got, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, got); diff != "" {
+		t.Fatalf("e.Observe(...): -want, +got: %s", diff)
+	}
+}
+
+func Test_external_Update_Drift(t *testing.T) {
+	type want struct {
+		err    error
+		bodies []string
+		source string
+	}
+
+	cases := map[string]struct {
+		code int
+		cr   *v1alpha1.PortOrder
+		want want
+	}{
+		"Amended": {
+			code: 200,
+			cr:   portOrder(withObservedEndpoints("10.9.0.0/24", "10.0.1.0/24")),
+			want: want{
+				bodies: []string{`{"order":{"source":"10.0.0.0/24","destination":"10.0.1.0/24"}}`},
+				source: "10.0.0.0/24",
+			},
+		},
+		"EveryRuleAmended": {
+			code: 204,
+			cr: portOrder(withObservedEndpoints("10.9.0.0/24", "10.0.1.0/24"), func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.OrderIDs = []string{testOrderID, "rule-2"}
+			}),
+			want: want{
+				bodies: []string{
+					`{"order":{"source":"10.0.0.0/24","destination":"10.0.1.0/24"}}`,
+					`{"order":{"source":"10.0.0.0/24","destination":"10.0.1.0/24"}}`,
+				},
+				source: "10.0.0.0/24",
+			},
+		},
+		"Immutable": {
+			code: 409,
+			cr:   portOrder(withObservedEndpoints("10.9.0.0/24", "10.0.1.0/24")),
+			want: want{
+				err:    errors.Errorf(errEndpointsImmutable, testOrderID),
+				bodies: []string{`{"order":{"source":"10.0.0.0/24","destination":"10.0.1.0/24"}}`},
+				source: "10.9.0.0/24",
+			},
+		},
+		"Failed": {
+			code: 500,
+			cr:   portOrder(withObservedEndpoints("10.9.0.0/24", "10.0.1.0/24")),
+			want: want{
+				err:    errors.Errorf(errAmendStatusCode, 500, ""),
+				bodies: []string{`{"order":{"source":"10.0.0.0/24","destination":"10.0.1.0/24"}}`},
+				source: "10.9.0.0/24",
+			},
+		},
+		"GraphQL": {
+			cr: portOrder(withObservedEndpoints("10.9.0.0/24", "10.0.1.0/24"), func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.Protocol = v1alpha1.ProtocolGraphQL
+			}),
+			want: want{
+				err:    errors.Errorf(errEndpointsImmutable, testOrderID),
+				source: "10.9.0.0/24",
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			var bodies []string
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: func(ctx context.Context, method string, url string, body httpclient.Data, headers httpclient.Data, skip bool) (httpclient.HttpDetails, error) {
+					bodies = append(bodies, body.Decrypted.(string))
+					return respondWith(tc.code, "")(ctx, method, url, body, headers, skip)
+				}},
+				logger: logging.NewNopLogger(),
+			}
+
+			_, err := e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.Update(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.bodies, bodies); diff != "" {
+				t.Fatalf("e.Update(...): -want bodies, +got bodies: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.source, tc.cr.Status.AtProvider.Source); diff != "" {
+				t.Fatalf("e.Update(...): -want observed source, +got observed source: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_observedPorts(t *testing.T) {
+	cases := map[string]struct {
+		body string
+		want []string
+	}{
+		"Names": {
+			body: `[{"protocol":"TCP","port":443},{"protocol":"udp","port":53}]`,
+			want: []string{"tcp/443", "udp/53"},
+		},
+		"Numbers": {
+			body: `[{"protocol":17,"port":53},{"protocol":6,"port":443},{"protocol":1}]`,
+			want: []string{"icmp", "tcp/443", "udp/53"},
+		},
+		"Duplicates": {
+			body: `[{"protocol":"TCP","port":443},{"protocol":6,"port":443}]`,
+			want: []string{"tcp/443"},
+		},
+		"UnknownProtocol": {
+			body: `[{"protocol":"SCTP","port":9}]`,
+			want: []string{"sctp/9"},
+		},
+		"Range": {
+			body: `[{"protocol":"TCP","port":8000,"endPort":8010},{"protocol":"TCP","port":443,"endPort":443}]`,
+			want: []string{"tcp/443", "tcp/8000-8010"},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			var entries []PortEntry
+			if err := decodeJSON(tc.body, &entries); err != nil {
+				t.Fatalf("decodeJSON(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, observedPorts(entries)); diff != "" {
+				t.Fatalf("observedPorts(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Observe_ExternalChange(t *testing.T) {
+	type want struct {
+		upToDate  bool
+		condition xpv1.Condition
+		observed  []string
+		events    []string
+	}
+
+	cases := map[string]struct {
+		ports  string
+		policy string
+		cr     *v1alpha1.PortOrder
+		want   want
+	}{
+		"NotReported": {
+			cr: portOrder(withObservedEndpoints("", "")),
+			want: want{
+				upToDate:  true,
+				condition: xpv1.Condition{Type: v1alpha1.TypeExternalChangeDetected, Status: "Unknown"},
+			},
+		},
+		"Unchanged": {
+			ports: `[{"protocol":"TCP","port":443}]`,
+			cr:    portOrder(withObservedEndpoints("", "")),
+			want: want{
+				upToDate:  true,
+				condition: v1alpha1.NoExternalChange(),
+				observed:  []string{"tcp/443"},
+			},
+		},
+		"ChangedWarn": {
+			ports: `[{"protocol":"TCP","port":443},{"protocol":"TCP","port":22}]`,
+			cr:    portOrder(withObservedEndpoints("", "")),
+			want: want{
+				upToDate:  true,
+				condition: v1alpha1.ExternalChangeDetected([]string{"tcp/22", "tcp/443"}, []string{"tcp/443"}),
+				observed:  []string{"tcp/22", "tcp/443"},
+				events:    []string{"backend has ports tcp/22, tcp/443 rather than tcp/443, which are left as they are"},
+			},
+		},
+		"ChangedWarnAlreadyDetected": {
+			ports: `[{"protocol":"TCP","port":22}]`,
+			cr: portOrder(withObservedEndpoints("", ""), func(po *v1alpha1.PortOrder) {
+				po.SetConditions(v1alpha1.ExternalChangeDetected([]string{"tcp/22"}, []string{"tcp/443"}))
+			}),
+			want: want{
+				upToDate:  true,
+				condition: v1alpha1.ExternalChangeDetected([]string{"tcp/22"}, []string{"tcp/443"}),
+				observed:  []string{"tcp/22"},
+			},
+		},
+		"ChangedReassert": {
+			ports:  `[{"protocol":"TCP","port":22}]`,
+			policy: v1alpha1.ExternalChangePolicyReassert,
+			cr:     portOrder(withObservedEndpoints("", "")),
+			want: want{
+				upToDate:  false,
+				condition: v1alpha1.ExternalChangeDetected([]string{"tcp/22"}, []string{"tcp/443"}),
+				observed:  []string{"tcp/22"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			body := `{"orderId":"order-123","status":"active"}`
+			if tc.ports != "" {
+				body = `{"orderId":"order-123","status":"active","ports":` + tc.ports + `}`
+			}
+			r := &eventRecorder{}
+			e := &external{
+				client:   &MockHttpClient{MockSendRequest: respondWith(200, body)},
+				logger:   logging.NewNopLogger(),
+				recorder: r,
+			}
+			tc.cr.Spec.ForProvider.ExternalChangePolicy = tc.policy
+
+			got, err := e.Observe(context.Background(), tc.cr)
+			if err != nil {
+				t.Fatalf("e.Observe(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, got.ResourceUpToDate); diff != "" {
+				t.Errorf("e.Observe(...): -want ResourceUpToDate, +got ResourceUpToDate: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, tc.cr.GetCondition(v1alpha1.TypeExternalChangeDetected), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("e.Observe(...): -want ExternalChangeDetected condition, +got ExternalChangeDetected condition: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.observed, tc.cr.Status.AtProvider.ObservedPorts); diff != "" {
+				t.Errorf("e.Observe(...): -want ObservedPorts, +got ObservedPorts: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.events, r.messages); diff != "" {
+				t.Errorf("e.Observe(...): -want events, +got events: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Update_ReassertPorts(t *testing.T) {
+	type want struct {
+		err      error
+		bodies   []string
+		observed []string
+	}
+
+	reassert := func(po *v1alpha1.PortOrder) {
+		po.Spec.ForProvider.ExternalChangePolicy = v1alpha1.ExternalChangePolicyReassert
+		po.Status.AtProvider.ObservedPorts = []string{"tcp/22"}
+	}
+
+	cases := map[string]struct {
+		code int
+		cr   *v1alpha1.PortOrder
+		want want
+	}{
+		"Reasserted": {
+			code: 200,
+			cr:   portOrder(withObservedEndpoints("", ""), reassert),
+			want: want{
+				bodies:   []string{`{"order":{"ports":[{"protocol":"TCP","port":443}]}}`},
+				observed: []string{"tcp/443"},
+			},
+		},
+		"Warn": {
+			cr: portOrder(withObservedEndpoints("", ""), func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.ObservedPorts = []string{"tcp/22"}
+			}),
+			want: want{observed: []string{"tcp/22"}},
+		},
+		"Immutable": {
+			code: 422,
+			cr:   portOrder(withObservedEndpoints("", ""), reassert),
+			want: want{
+				err:      errors.Errorf(errPortsImmutable, testOrderID),
+				bodies:   []string{`{"order":{"ports":[{"protocol":"TCP","port":443}]}}`},
+				observed: []string{"tcp/22"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			var bodies []string
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: func(ctx context.Context, method string, url string, body httpclient.Data, headers httpclient.Data, skip bool) (httpclient.HttpDetails, error) {
+					bodies = append(bodies, body.Decrypted.(string))
+					return respondWith(tc.code, "")(ctx, method, url, body, headers, skip)
+				}},
+				logger: logging.NewNopLogger(),
+			}
+
+			_, err := e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.Update(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.bodies, bodies); diff != "" {
+				t.Fatalf("e.Update(...): -want bodies, +got bodies: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.observed, tc.cr.Status.AtProvider.ObservedPorts); diff != "" {
+				t.Fatalf("e.Update(...): -want ObservedPorts, +got ObservedPorts: %s", diff)
+			}
+		})
+	}
+}
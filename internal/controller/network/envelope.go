@@ -0,0 +1,233 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"mime"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+const (
+	errEnvelopeNoKey         = "encryptPayload requires the encryptionPublicKey of the orders API in the credentials"
+	errEnvelopeNoDecryptKey  = "orders API sent an encrypted response, but the credentials have no decryptionPrivateKey"
+	errEnvelopePublicKey     = "cannot parse encryptionPublicKey"
+	errEnvelopePrivateKey    = "cannot parse decryptionPrivateKey"
+	errEnvelopeNotRSA        = "key is not an RSA key"
+	errEnvelopeNoPEM         = "key is not PEM encoded"
+	errEnvelopeEncrypt       = "cannot encrypt request body"
+	errEnvelopeDecrypt       = "cannot decrypt response body"
+	errEnvelopeCompact       = "response body is not a compact JWE"
+	errEnvelopeUnsupported   = "unsupported JWE algorithm %q or encryption %q"
+	errEnvelopeKeyLength     = "JWE content encryption key has %d bytes rather than 32"
+	errEnvelopeInvalidHeader = "cannot decode JWE header"
+)
+
+// JWE parameters of encrypted payloads. Content encryption keys are wrapped
+// with RSA-OAEP using SHA-256, and payloads encrypted with AES-256-GCM.
+const (
+	jweContentType = "application/jose"
+	jweAlgorithm   = "RSA-OAEP-256"
+	jweEncryption  = "A256GCM"
+	jweKeyBytes    = 32
+	jweIVBytes     = 12
+)
+
+type jweHeader struct {
+	Algorithm   string `json:"alg"`
+	Encryption  string `json:"enc"`
+	KeyID       string `json:"kid,omitempty"`
+	ContentType string `json:"cty,omitempty"`
+}
+
+// payloadEnvelope encrypts request bodies to the public key of the orders API
+// as compact JWE, and decrypts the JWE responses it encrypts to the private
+// key of the provider.
+type payloadEnvelope struct {
+	public *rsa.PublicKey
+	keyID  string
+	// private is nil if the orders API does not encrypt its responses.
+	private *rsa.PrivateKey
+}
+
+// newPayloadEnvelope returns the envelope of the keys of the credentials.
+func newPayloadEnvelope(config credentialsConfig) (*payloadEnvelope, error) {
+	if config.EncryptionPublicKey == "" {
+		return nil, errors.New(errEnvelopeNoKey)
+	}
+	public, err := parseRSAPublicKey(config.EncryptionPublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, errEnvelopePublicKey)
+	}
+	p := &payloadEnvelope{public: public, keyID: config.EncryptionKeyID}
+	if config.DecryptionPrivateKey != "" {
+		if p.private, err = parseRSAPrivateKey(config.DecryptionPrivateKey); err != nil {
+			return nil, errors.Wrap(err, errEnvelopePrivateKey)
+		}
+	}
+	return p, nil
+}
+
+// parseRSAPublicKey parses a PEM encoded PKIX or PKCS #1 RSA public key.
+func parseRSAPublicKey(s string) (*rsa.PublicKey, error) {
+	b, _ := pem.Decode([]byte(s))
+	if b == nil {
+		return nil, errors.New(errEnvelopeNoPEM)
+	}
+	if k, err := x509.ParsePKCS1PublicKey(b.Bytes); err == nil {
+		return k, nil
+	}
+	k, err := x509.ParsePKIXPublicKey(b.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rk, ok := k.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New(errEnvelopeNotRSA)
+	}
+	return rk, nil
+}
+
+// parseRSAPrivateKey parses a PEM encoded PKCS #8 or PKCS #1 RSA private key.
+func parseRSAPrivateKey(s string) (*rsa.PrivateKey, error) {
+	b, _ := pem.Decode([]byte(s))
+	if b == nil {
+		return nil, errors.New(errEnvelopeNoPEM)
+	}
+	if k, err := x509.ParsePKCS1PrivateKey(b.Bytes); err == nil {
+		return k, nil
+	}
+	k, err := x509.ParsePKCS8PrivateKey(b.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rk, ok := k.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New(errEnvelopeNotRSA)
+	}
+	return rk, nil
+}
+
+// encrypt returns the body encrypted as compact JWE.
+func (p *payloadEnvelope) encrypt(body string) (string, error) {
+	header, err := json.Marshal(jweHeader{Algorithm: jweAlgorithm, Encryption: jweEncryption, KeyID: p.keyID, ContentType: "application/json"})
+	if err != nil {
+		return "", errors.Wrap(err, errEnvelopeEncrypt)
+	}
+	protected := base64.RawURLEncoding.EncodeToString(header)
+
+	key := make([]byte, jweKeyBytes)
+	iv := make([]byte, jweIVBytes)
+	if _, err := rand.Read(key); err != nil {
+		return "", errors.Wrap(err, errEnvelopeEncrypt)
+	}
+	if _, err := rand.Read(iv); err != nil {
+		return "", errors.Wrap(err, errEnvelopeEncrypt)
+	}
+	wrapped, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, p.public, key, nil)
+	if err != nil {
+		return "", errors.Wrap(err, errEnvelopeEncrypt)
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", errors.Wrap(err, errEnvelopeEncrypt)
+	}
+	// The tag is appended to the ciphertext.
+	sealed := gcm.Seal(nil, iv, []byte(body), []byte(protected))
+	ciphertext, tag := sealed[:len(sealed)-gcm.Overhead()], sealed[len(sealed)-gcm.Overhead():]
+
+	return strings.Join([]string{
+		protected,
+		base64.RawURLEncoding.EncodeToString(wrapped),
+		base64.RawURLEncoding.EncodeToString(iv),
+		base64.RawURLEncoding.EncodeToString(ciphertext),
+		base64.RawURLEncoding.EncodeToString(tag),
+	}, "."), nil
+}
+
+// decrypt returns the payload of a compact JWE.
+func (p *payloadEnvelope) decrypt(jwe string) (string, error) {
+	if p.private == nil {
+		return "", errors.New(errEnvelopeNoDecryptKey)
+	}
+	parts := strings.Split(strings.TrimSpace(jwe), ".")
+	if len(parts) != 5 {
+		return "", errors.New(errEnvelopeCompact)
+	}
+	decoded := make([][]byte, len(parts))
+	for i, part := range parts {
+		b, err := base64.RawURLEncoding.DecodeString(part)
+		if err != nil {
+			return "", errors.Wrap(err, errEnvelopeCompact)
+		}
+		decoded[i] = b
+	}
+
+	var h jweHeader
+	if err := json.Unmarshal(decoded[0], &h); err != nil {
+		return "", errors.Wrap(err, errEnvelopeInvalidHeader)
+	}
+	if h.Algorithm != jweAlgorithm || h.Encryption != jweEncryption {
+		return "", errors.Errorf(errEnvelopeUnsupported, h.Algorithm, h.Encryption)
+	}
+	key, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, p.private, decoded[1], nil)
+	if err != nil {
+		return "", errors.Wrap(err, errEnvelopeDecrypt)
+	}
+	if len(key) != jweKeyBytes {
+		return "", errors.Errorf(errEnvelopeKeyLength, len(key))
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", errors.Wrap(err, errEnvelopeDecrypt)
+	}
+	if len(decoded[2]) != gcm.NonceSize() {
+		return "", errors.New(errEnvelopeCompact)
+	}
+	plaintext, err := gcm.Open(nil, decoded[2], append(decoded[3], decoded[4]...), []byte(parts[0]))
+	if err != nil {
+		return "", errors.Wrap(err, errEnvelopeDecrypt)
+	}
+	return string(plaintext), nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encryptedResponse reports whether the response body is a JWE, according
+// to its content type.
+func encryptedResponse(resp httpclient.HttpResponse) bool {
+	t, _, err := mime.ParseMediaType(headerValue(resp.Headers, "Content-Type"))
+	return err == nil && t == jweContentType
+}
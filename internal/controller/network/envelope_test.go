@@ -0,0 +1,299 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+// testKeyPair returns the credentials of a freshly generated RSA key pair,
+// PEM encoded as PKIX and PKCS #8.
+func testKeyPair(t *testing.T) credentialsConfig {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey(...): %v", err)
+	}
+	public, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("x509.MarshalPKIXPublicKey(...): %v", err)
+	}
+	private, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("x509.MarshalPKCS8PrivateKey(...): %v", err)
+	}
+	return credentialsConfig{
+		EncryptionPublicKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: public})),
+		EncryptionKeyID:      "test-key",
+		DecryptionPrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: private})),
+	}
+}
+
+func Test_payloadEnvelope(t *testing.T) {
+	keys := testKeyPair(t)
+	other := testKeyPair(t)
+
+	type want struct {
+		body string
+		err  error
+	}
+
+	cases := map[string]struct {
+		encrypt credentialsConfig
+		decrypt credentialsConfig
+		jwe     func(string) string
+		want    want
+	}{
+		"RoundTrip": {
+			encrypt: keys,
+			decrypt: keys,
+			want:    want{body: `{"order":{"ports":[]}}`},
+		},
+		"OtherKey": {
+			encrypt: keys,
+			decrypt: other,
+			want:    want{err: errBoom},
+		},
+		"Tampered": {
+			encrypt: keys,
+			decrypt: keys,
+			jwe: func(jwe string) string {
+				parts := strings.Split(jwe, ".")
+				parts[0] = "eyJhbGciOiJSU0EtT0FFUC0yNTYiLCJlbmMiOiJBMjU2R0NNIn0"
+				return strings.Join(parts, ".")
+			},
+			want: want{err: errBoom},
+		},
+		"NotCompact": {
+			encrypt: keys,
+			decrypt: keys,
+			jwe:     func(string) string { return "not-a-jwe" },
+			want:    want{err: errBoom},
+		},
+		"NoPrivateKey": {
+			encrypt: keys,
+			decrypt: credentialsConfig{EncryptionPublicKey: keys.EncryptionPublicKey},
+			want:    want{err: errBoom},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			encrypter, err := newPayloadEnvelope(tc.encrypt)
+			if err != nil {
+				t.Fatalf("newPayloadEnvelope(...): %v", err)
+			}
+			decrypter, err := newPayloadEnvelope(tc.decrypt)
+			if err != nil {
+				t.Fatalf("newPayloadEnvelope(...): %v", err)
+			}
+
+			jwe, err := encrypter.encrypt(`{"order":{"ports":[]}}`)
+			if err != nil {
+				t.Fatalf("encrypt(...): %v", err)
+			}
+			if tc.jwe != nil {
+				jwe = tc.jwe(jwe)
+			}
+			body, err := decrypter.decrypt(jwe)
+			if diff := cmp.Diff(tc.want.err != nil, err != nil); diff != "" {
+				t.Fatalf("decrypt(...): -want error, +got error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.body, body); diff != "" {
+				t.Errorf("decrypt(...): -want body, +got body: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_newPayloadEnvelope(t *testing.T) {
+	keys := testKeyPair(t)
+
+	cases := map[string]struct {
+		config credentialsConfig
+		err    error
+	}{
+		"Keys": {
+			config: keys,
+		},
+		"PublicKeyOnly": {
+			config: credentialsConfig{EncryptionPublicKey: keys.EncryptionPublicKey},
+		},
+		"NoPublicKey": {
+			config: credentialsConfig{DecryptionPrivateKey: keys.DecryptionPrivateKey},
+			err:    errBoom,
+		},
+		"NotPEM": {
+			config: credentialsConfig{EncryptionPublicKey: "not-a-key"},
+			err:    errBoom,
+		},
+		"PrivateKeyAsPublicKey": {
+			config: credentialsConfig{EncryptionPublicKey: keys.DecryptionPrivateKey},
+			err:    errBoom,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			_, err := newPayloadEnvelope(tc.config)
+			if diff := cmp.Diff(tc.err != nil, err != nil); diff != "" {
+				t.Errorf("newPayloadEnvelope(...): -want error, +got error: %v", err)
+			}
+		})
+	}
+}
+
+func Test_external_EncryptPayload(t *testing.T) {
+	keys := testKeyPair(t)
+	envelope, err := newPayloadEnvelope(keys)
+	if err != nil {
+		t.Fatalf("newPayloadEnvelope(...): %v", err)
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		var sent, logged, contentType string
+		e := &external{
+			client: &MockHttpClient{MockSendRequest: func(_ context.Context, _ string, _ string, body httpclient.Data, headers httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+				sent, logged = body.Decrypted.(string), body.Encrypted.(string)
+				contentType = headerValue(headers.Decrypted.(map[string][]string), "Content-Type")
+				return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 201, Body: `{"orderId":"order-123","status":"pending"}`}}, nil
+			}},
+			logger:   logging.NewNopLogger(),
+			envelope: envelope,
+		}
+		cr := portOrder()
+
+		if _, err := e.Create(context.Background(), cr); err != nil {
+			t.Fatalf("e.Create(...): unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(jweContentType, contentType); diff != "" {
+			t.Errorf("e.Create(...): -want Content-Type, +got Content-Type: %s", diff)
+		}
+		if diff := cmp.Diff(sent, logged); diff != "" {
+			t.Errorf("e.Create(...): -want logged body, +got logged body: %s", diff)
+		}
+		body, err := envelope.decrypt(sent)
+		if err != nil {
+			t.Fatalf("decrypt(...): %v", err)
+		}
+		if !strings.Contains(body, `"ports"`) {
+			t.Errorf("e.Create(...): decrypted body %s is not the order", body)
+		}
+		if diff := cmp.Diff(testOrderID, cr.Status.AtProvider.OrderID); diff != "" {
+			t.Errorf("e.Create(...): -want OrderID, +got OrderID: %s", diff)
+		}
+	})
+
+	t.Run("CreateWithToken", func(t *testing.T) {
+		tokenURL := "https://auth.example.com/token"
+		var sent, logged map[string][]string
+		h := &MockHttpClient{MockSendRequest: func(_ context.Context, _ string, url string, _ httpclient.Data, headers httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+			if url == tokenURL {
+				return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 200, Body: `{"access_token":"token","expires_in":3600}`}}, nil
+			}
+			sent, logged = headers.Decrypted.(map[string][]string), headers.Encrypted.(map[string][]string)
+			return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 201, Body: `{"orderId":"order-123","status":"pending"}`}}, nil
+		}}
+		e := &external{
+			client: h,
+			logger: logging.NewNopLogger(),
+			tokens: &tokenSource{
+				client: h,
+				cache:  newTokenCache(),
+				config: credentialsConfig{AuthType: authTypeOAuth2, TokenURL: tokenURL, ClientID: "id"},
+			},
+			envelope: envelope,
+		}
+
+		if _, err := e.Create(context.Background(), portOrder()); err != nil {
+			t.Fatalf("e.Create(...): unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(jweContentType, headerValue(sent, "Content-Type")); diff != "" {
+			t.Errorf("e.Create(...): -want sent Content-Type, +got sent Content-Type: %s", diff)
+		}
+		if diff := cmp.Diff(jweContentType, headerValue(logged, "Content-Type")); diff != "" {
+			t.Errorf("e.Create(...): -want logged Content-Type, +got logged Content-Type: %s", diff)
+		}
+		if diff := cmp.Diff("Bearer token", headerValue(sent, authKey)); diff != "" {
+			t.Errorf("e.Create(...): -want sent Authorization, +got sent Authorization: %s", diff)
+		}
+	})
+
+	t.Run("Observe", func(t *testing.T) {
+		jwe, err := envelope.encrypt(`{"orderId":"order-123","status":"active"}`)
+		if err != nil {
+			t.Fatalf("encrypt(...): %v", err)
+		}
+		e := &external{
+			client: &MockHttpClient{MockSendRequest: func(_ context.Context, _ string, _ string, _ httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+				return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{
+					StatusCode: 200,
+					Body:       jwe,
+					Headers:    map[string][]string{"Content-Type": {"application/jose; charset=utf-8"}},
+				}}, nil
+			}},
+			logger:   logging.NewNopLogger(),
+			envelope: envelope,
+		}
+		cr := portOrder(func(po *v1alpha1.PortOrder) { po.Status.AtProvider.OrderID = testOrderID })
+
+		if _, err := e.Observe(context.Background(), cr); err != nil {
+			t.Fatalf("e.Observe(...): unexpected error: %v", err)
+		}
+		if diff := cmp.Diff("active", cr.Status.AtProvider.Status); diff != "" {
+			t.Errorf("e.Observe(...): -want Status, +got Status: %s", diff)
+		}
+	})
+
+	t.Run("ObserveWithoutPrivateKey", func(t *testing.T) {
+		public, err := newPayloadEnvelope(credentialsConfig{EncryptionPublicKey: keys.EncryptionPublicKey})
+		if err != nil {
+			t.Fatalf("newPayloadEnvelope(...): %v", err)
+		}
+		e := &external{
+			client: &MockHttpClient{MockSendRequest: func(_ context.Context, _ string, _ string, _ httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+				return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{
+					StatusCode: 200,
+					Body:       "a.b.c.d.e",
+					Headers:    map[string][]string{"Content-Type": {jweContentType}},
+				}}, nil
+			}},
+			logger:   logging.NewNopLogger(),
+			envelope: public,
+		}
+		cr := portOrder(func(po *v1alpha1.PortOrder) { po.Status.AtProvider.OrderID = testOrderID })
+
+		if _, err := e.Observe(context.Background(), cr); err == nil {
+			t.Errorf("e.Observe(...): want error decrypting the response, got none")
+		}
+	})
+}
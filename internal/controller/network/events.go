@@ -0,0 +1,189 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+	"github.com/crossplane-contrib/provider-http/internal/utils"
+)
+
+// Lifecycle events of an order.
+const (
+	orderEventCreated = "created"
+	orderEventReady   = "ready"
+	orderEventFailed  = "failed"
+	orderEventDeleted = "deleted"
+)
+
+const (
+	errEventMarshal    = "cannot marshal order event"
+	errEventSend       = "cannot send order event"
+	errEventStatusCode = "event sink returned status code %d"
+
+	// eventAttempts bounds how often the delivery of an event is attempted.
+	eventAttempts       = 3
+	eventTimeout        = 10 * time.Second
+	defaultEventBackoff = time.Second
+)
+
+// orderEvent is the envelope of the lifecycle events published to the event
+// sink of an order.
+type orderEvent struct {
+	Event    string    `json:"event"`
+	Name     string    `json:"name"`
+	OrderID  string    `json:"orderId"`
+	OrderIDs []string  `json:"orderIds,omitempty"`
+	Status   string    `json:"status,omitempty"`
+	Time     time.Time `json:"time"`
+}
+
+// eventPublisher delivers order events in the background. It uses a client
+// of its own, so the credentials of the orders API are never sent to an
+// event sink.
+type eventPublisher struct {
+	client  httpclient.Client
+	logger  logging.Logger
+	backoff time.Duration
+
+	// wg tracks the deliveries in progress.
+	wg sync.WaitGroup
+}
+
+func newEventPublisher(log logging.Logger) (*eventPublisher, error) {
+	h, err := httpclient.NewClient(log, eventTimeout, "")
+	if err != nil {
+		return nil, err
+	}
+	return &eventPublisher{client: h, logger: log, backoff: defaultEventBackoff}, nil
+}
+
+// publish delivers the event to the endpoint without waiting for it.
+// Deliveries that still fail after a few attempts are logged and dropped.
+func (p *eventPublisher) publish(endpoint, auth string, ev orderEvent) {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		p.logger.Info("Cannot publish order event", "event", ev.Event, "name", ev.Name, "error", errors.Wrap(err, errEventMarshal))
+		return
+	}
+	p.post(endpoint, auth, body, func(err error) {
+		p.logger.Info("Cannot publish order event", "event", ev.Event, "name", ev.Name, "endpoint", endpoint, "error", err)
+	})
+}
+
+// post delivers the JSON body to the endpoint without waiting for it. If it
+// cannot be delivered, failed is called with the error.
+func (p *eventPublisher) post(endpoint, auth string, body []byte, failed func(err error)) {
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		if err := p.deliver(context.Background(), endpoint, auth, body); err != nil {
+			failed(err)
+		}
+	}()
+}
+
+// deliver sends the JSON body to the endpoint, doubling the wait between
+// attempts.
+func (p *eventPublisher) deliver(ctx context.Context, endpoint, auth string, body []byte) error {
+	headers := map[string][]string{"Content-Type": {"application/json"}}
+	sensitive := headers
+	if auth != "" {
+		sensitive = map[string][]string{"Content-Type": {"application/json"}, authKey: {auth}}
+	}
+
+	backoff := p.backoff
+	for attempt := 1; ; attempt++ {
+		err := p.send(ctx, endpoint, string(body), headers, sensitive)
+		if err == nil || attempt == eventAttempts {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (p *eventPublisher) send(ctx context.Context, endpoint, body string, headers, sensitive map[string][]string) error {
+	ctx, cancel := context.WithTimeout(ctx, eventTimeout)
+	defer cancel()
+
+	details, err := p.client.SendRequest(ctx, http.MethodPost, endpoint,
+		httpclient.Data{Encrypted: body, Decrypted: body},
+		httpclient.Data{Encrypted: headers, Decrypted: sensitive},
+		false)
+	if err != nil {
+		return errors.Wrap(err, errEventSend)
+	}
+	if !utils.IsHTTPSuccess(details.HttpResponse.StatusCode) {
+		return errors.Errorf(errEventStatusCode, details.HttpResponse.StatusCode)
+	}
+	return nil
+}
+
+// publishEvent publishes the lifecycle event to the event sink of the order,
+// if it has one. Events that cannot be published are logged and dropped.
+func (e *external) publishEvent(ctx context.Context, cr *v1alpha1.PortOrder, event string) {
+	sink := cr.Spec.ForProvider.EventSink
+	if sink == nil || e.events == nil {
+		return
+	}
+
+	if err := validateEndpoint(sink.Endpoint); err != nil {
+		e.logger.Info("Cannot publish order event", "event", event, "error", err)
+		return
+	}
+	var auth string
+	if ref := sink.CredentialsSecretRef; ref != nil {
+		var err error
+		if auth, err = orderCredentials(ctx, e.kube, *ref); err != nil {
+			e.logger.Info("Cannot publish order event", "event", event, "error", err)
+			return
+		}
+	}
+
+	e.events.publish(sink.Endpoint, auth, orderEvent{
+		Event:    event,
+		Name:     cr.GetName(),
+		OrderID:  cr.Status.AtProvider.OrderID,
+		OrderIDs: cr.Status.AtProvider.OrderIDs,
+		Status:   cr.Status.AtProvider.Status,
+		Time:     time.Now().UTC(),
+	})
+}
+
+// finalEvent returns the lifecycle event of an order that reached a ready or
+// failed status.
+func finalEvent(cr *v1alpha1.PortOrder) string {
+	if isFailed(cr) {
+		return orderEventFailed
+	}
+	return orderEventReady
+}
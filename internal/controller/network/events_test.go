@@ -0,0 +1,216 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+const testEventEndpoint = "https://itsm.example.com/events"
+
+// sentEvent is an event request received by the event sink.
+type sentEvent struct {
+	auth   []string
+	logged []string
+	event  orderEvent
+}
+
+// recordEvents records the event requests it receives, responding with the
+// supplied status codes in order and repeating the last one.
+func recordEvents(sent *[]sentEvent, codes ...int) MockSendRequestFn {
+	return func(_ context.Context, _ string, _ string, body httpclient.Data, headers httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+		ev := orderEvent{}
+		_ = json.Unmarshal([]byte(body.Decrypted.(string)), &ev)
+		*sent = append(*sent, sentEvent{
+			auth:   headers.Decrypted.(map[string][]string)[authKey],
+			logged: headers.Encrypted.(map[string][]string)[authKey],
+			event:  ev,
+		})
+		code := codes[len(codes)-1]
+		if len(*sent) <= len(codes) {
+			code = codes[len(*sent)-1]
+		}
+		return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: code}}, nil
+	}
+}
+
+func Test_eventPublisher_deliver(t *testing.T) {
+	type want struct {
+		attempts int
+		err      error
+	}
+
+	cases := map[string]struct {
+		codes []int
+		want  want
+	}{
+		"Delivered": {
+			codes: []int{202},
+			want:  want{attempts: 1},
+		},
+		"RetriedUntilDelivered": {
+			codes: []int{503, 500, 200},
+			want:  want{attempts: 3},
+		},
+		"AttemptsExhausted": {
+			codes: []int{503},
+			want: want{
+				attempts: eventAttempts,
+				err:      errors.Errorf(errEventStatusCode, 503),
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			var sent []sentEvent
+			p := &eventPublisher{
+				client: &MockHttpClient{MockSendRequest: recordEvents(&sent, tc.codes...)},
+				logger: logging.NewNopLogger(),
+			}
+
+			err := p.deliver(context.Background(), testEventEndpoint, "", []byte(`{"event":"created"}`))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("p.deliver(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.attempts, len(sent)); diff != "" {
+				t.Fatalf("p.deliver(...): -want attempts, +got attempts: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_publishEvent(t *testing.T) {
+	ref := &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "itsm"},
+		Key:             "token",
+	}
+
+	cases := map[string]struct {
+		sink   *v1alpha1.EventSink
+		secret *corev1.Secret
+		want   []sentEvent
+	}{
+		"NoSink": {},
+		"Published": {
+			sink: &v1alpha1.EventSink{Endpoint: testEventEndpoint},
+			want: []sentEvent{{event: orderEvent{
+				Event:   orderEventReady,
+				Name:    "test-order",
+				OrderID: testOrderID,
+				Status:  "active",
+			}}},
+		},
+		"Authenticated": {
+			sink:   &v1alpha1.EventSink{Endpoint: testEventEndpoint, CredentialsSecretRef: ref},
+			secret: &corev1.Secret{Data: map[string][]byte{"token": []byte("Bearer itsm")}},
+			want: []sentEvent{{
+				auth: []string{"Bearer itsm"},
+				event: orderEvent{
+					Event:   orderEventReady,
+					Name:    "test-order",
+					OrderID: testOrderID,
+					Status:  "active",
+				},
+			}},
+		},
+		"SecretMissing": {
+			sink: &v1alpha1.EventSink{Endpoint: testEventEndpoint, CredentialsSecretRef: ref},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			var sent []sentEvent
+			p := &eventPublisher{
+				client: &MockHttpClient{MockSendRequest: recordEvents(&sent, 200)},
+				logger: logging.NewNopLogger(),
+			}
+			e := &external{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+						if tc.secret == nil {
+							return kerrors.NewNotFound(corev1.Resource("secrets"), key.Name)
+						}
+						tc.secret.DeepCopyInto(obj.(*corev1.Secret))
+						return nil
+					},
+				},
+				logger: logging.NewNopLogger(),
+				events: p,
+			}
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.SetName("test-order")
+				po.Spec.ForProvider.EventSink = tc.sink
+				po.Status.AtProvider.OrderID = testOrderID
+				po.Status.AtProvider.Status = "active"
+			})
+
+			e.publishEvent(context.Background(), cr, orderEventReady)
+			p.wg.Wait()
+			if diff := cmp.Diff(tc.want, sent, cmp.AllowUnexported(sentEvent{}), cmpopts.IgnoreFields(orderEvent{}, "Time")); diff != "" {
+				t.Fatalf("e.publishEvent(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_finalEvent(t *testing.T) {
+	cases := map[string]struct {
+		status string
+		want   string
+	}{
+		"Ready": {
+			status: "active",
+			want:   orderEventReady,
+		},
+		"Failed": {
+			status: "failed",
+			want:   orderEventFailed,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.Status = tc.status
+			})
+			if diff := cmp.Diff(tc.want, finalEvent(cr)); diff != "" {
+				t.Fatalf("finalEvent(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+// checksExistenceWithHEAD reports whether the order is checked to exist with
+// a HEAD request rather than read with GET. This is only done once the order
+// is ready, since its status is not read any more.
+func checksExistenceWithHEAD(cr *v1alpha1.PortOrder) bool {
+	p := cr.Spec.ForProvider
+	return p.ExistenceCheckMethod == v1alpha1.ExistenceCheckMethodHEAD && !isGraphQL(p) && isReady(cr)
+}
+
+// headOrders checks with HEAD requests that every rule object of the order
+// exists. It reports whether they all exist, and whether the backend supports
+// HEAD at all. If it does not, the order must be read with GET instead.
+func (e *external) headOrders(ctx context.Context, cr *v1alpha1.PortOrder, endpoint string) (exists, supported bool, err error) {
+	for _, id := range orderIDs(cr) {
+		details, err := e.send(ctx, http.MethodHead, orderURL(endpoint, id), "", nil)
+		if err != nil {
+			return false, false, errors.Wrap(err, errObserve)
+		}
+
+		cr.Status.AtProvider.LastResponseStatus = details.HttpResponse.StatusCode
+
+		code := details.HttpResponse.StatusCode
+		if code == http.StatusMethodNotAllowed {
+			return false, false, nil
+		}
+		switch statusAction(cr.Spec.ForProvider, false, code) {
+		case v1alpha1.StatusCodeActionSuccess:
+		case v1alpha1.StatusCodeActionNotExists:
+			return false, true, nil
+		case v1alpha1.StatusCodeActionTerminal:
+			return false, true, &terminalStatusError{code: code}
+		default:
+			return false, true, errors.Errorf("unexpected status code: %d", code)
+		}
+	}
+	return true, true, nil
+}
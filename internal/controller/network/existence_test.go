@@ -0,0 +1,151 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+func Test_external_Observe_ExistenceCheckMethod(t *testing.T) {
+	type args struct {
+		method    string
+		status    string
+		responses map[string]int
+	}
+	type want struct {
+		obs      managed.ExternalObservation
+		err      error
+		requests []string
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"GET": {
+			args: args{
+				method:    v1alpha1.ExistenceCheckMethodGET,
+				status:    "active",
+				responses: map[string]int{http.MethodGet: 200},
+			},
+			want: want{
+				obs:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				requests: []string{http.MethodGet},
+			},
+		},
+		"HEADExists": {
+			args: args{
+				method:    v1alpha1.ExistenceCheckMethodHEAD,
+				status:    "active",
+				responses: map[string]int{http.MethodHead: 200},
+			},
+			want: want{
+				obs:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				requests: []string{http.MethodHead},
+			},
+		},
+		"HEADNotFound": {
+			args: args{
+				method:    v1alpha1.ExistenceCheckMethodHEAD,
+				status:    "active",
+				responses: map[string]int{http.MethodHead: 404},
+			},
+			want: want{
+				obs:      managed.ExternalObservation{ResourceExists: false},
+				requests: []string{http.MethodHead},
+			},
+		},
+		"HEADNotAllowed": {
+			args: args{
+				method:    v1alpha1.ExistenceCheckMethodHEAD,
+				status:    "active",
+				responses: map[string]int{http.MethodHead: 405, http.MethodGet: 200},
+			},
+			want: want{
+				obs:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				requests: []string{http.MethodHead, http.MethodGet},
+			},
+		},
+		"HEADFailed": {
+			args: args{
+				method:    v1alpha1.ExistenceCheckMethodHEAD,
+				status:    "active",
+				responses: map[string]int{http.MethodHead: 500},
+			},
+			want: want{
+				err:      errors.New("unexpected status code: 500"),
+				requests: []string{http.MethodHead},
+			},
+		},
+		"HEADNotReadyYet": {
+			args: args{
+				method:    v1alpha1.ExistenceCheckMethodHEAD,
+				status:    "pending",
+				responses: map[string]int{http.MethodGet: 200},
+			},
+			want: want{
+				obs:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				requests: []string{http.MethodGet},
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			var requests []string
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: func(_ context.Context, method string, _ string, _ httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+					requests = append(requests, method)
+					return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{
+						StatusCode: tc.args.responses[method],
+						Body:       `{"orderId":"order-123","status":"active"}`,
+					}}, nil
+				}},
+				logger: logging.NewNopLogger(),
+			}
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.ExistenceCheckMethod = tc.args.method
+				po.Status.AtProvider.OrderID = testOrderID
+				po.Status.AtProvider.Status = tc.args.status
+			})
+
+			got, err := e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.Observe(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("e.Observe(...): -want, +got: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.requests, requests); diff != "" {
+				t.Errorf("requests: -want, +got: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+const (
+	errDeleteExpired = "cannot delete expired PortOrder"
+
+	// minExpiryPollInterval is the shortest wait before an order is
+	// observed again to expire it.
+	minExpiryPollInterval = time.Second
+)
+
+// maxLifetime returns the maximum lifetime of the order, if it has one.
+func maxLifetime(p v1alpha1.PortOrderParameters) (time.Duration, bool) {
+	if p.MaxLifetimeSeconds == nil {
+		return 0, false
+	}
+	return time.Duration(*p.MaxLifetimeSeconds) * time.Second, true
+}
+
+// untilExpiry returns how long the order has left until it expires. It
+// reports false for orders that do not expire, or that already did.
+func untilExpiry(cr *v1alpha1.PortOrder, now time.Time) (time.Duration, bool) {
+	lifetime, ok := maxLifetime(cr.Spec.ForProvider)
+	if !ok || cr.Status.AtProvider.ExpiredTime != nil || meta.WasDeleted(cr) {
+		return 0, false
+	}
+	return cr.GetCreationTimestamp().Add(lifetime).Sub(now), true
+}
+
+// expire cancels an order that exceeded its maximum lifetime, and deletes
+// its PortOrder unless the expiry action is to only cancel it. It reports
+// whether the order expired, in which case it is not observed any further.
+func (e *external) expire(ctx context.Context, cr *v1alpha1.PortOrder, now time.Time) (bool, error) {
+	lifetime, ok := maxLifetime(cr.Spec.ForProvider)
+	if !ok && cr.Status.AtProvider.ExpiredTime == nil {
+		return false, nil
+	}
+
+	if cr.Status.AtProvider.ExpiredTime == nil {
+		if left, ok := untilExpiry(cr, now); !ok || left > 0 {
+			return false, nil
+		}
+
+		e.logger.Debug("Cancelling expired PortOrder", "name", cr.GetName(), "orderID", cr.Status.AtProvider.OrderID)
+		defer e.orders.invalidate(orderIDs(cr)...)
+		if err := e.cancel(ctx, cr); err != nil {
+			return true, err
+		}
+		e.publishEvent(ctx, cr, orderEventDeleted)
+
+		// The PortOrder is deleted by the next observation, once its
+		// cancellation was recorded.
+		t := metav1.NewTime(now)
+		cr.Status.AtProvider.ExpiredTime = &t
+		cr.SetConditions(v1alpha1.Expired(lifetime))
+		return true, nil
+	}
+
+	cr.SetConditions(v1alpha1.Expired(lifetime))
+	if cr.Spec.ForProvider.ExpiryAction == v1alpha1.ExpiryActionCancel {
+		return true, nil
+	}
+	return true, errors.Wrap(resource.IgnoreNotFound(e.kube.Delete(ctx, cr)), errDeleteExpired)
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+func withLifetime(created time.Time, seconds int64, action string) portOrderModifier {
+	return func(po *v1alpha1.PortOrder) {
+		po.SetCreationTimestamp(metav1.NewTime(created))
+		po.Spec.ForProvider.MaxLifetimeSeconds = ptr.To(seconds)
+		po.Spec.ForProvider.ExpiryAction = action
+		po.Status.AtProvider.OrderID = testOrderID
+	}
+}
+
+func withExpired(po *v1alpha1.PortOrder) {
+	t := metav1.Now()
+	po.Status.AtProvider.ExpiredTime = &t
+	po.Status.AtProvider.CancelRequestTime = &t
+}
+
+func Test_expiryPollInterval(t *testing.T) {
+	now := time.Now()
+	pollInterval := time.Minute
+
+	cases := map[string]struct {
+		cr   *v1alpha1.PortOrder
+		want time.Duration
+	}{
+		"NoLifetime": {
+			cr:   portOrder(),
+			want: pollInterval,
+		},
+		"ExpiresAfterPoll": {
+			cr:   portOrder(withLifetime(now, 3600, "")),
+			want: pollInterval,
+		},
+		"ExpiresBeforePoll": {
+			cr:   portOrder(withLifetime(now.Add(-50*time.Second), 60, "")),
+			want: 10 * time.Second,
+		},
+		"Overdue": {
+			cr:   portOrder(withLifetime(now.Add(-time.Hour), 60, "")),
+			want: minExpiryPollInterval,
+		},
+		"AlreadyExpired": {
+			cr:   portOrder(withLifetime(now.Add(-time.Hour), 60, ""), withExpired),
+			want: pollInterval,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got := expiryPollInterval(tc.cr, pollInterval, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Fatalf("expiryPollInterval(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Observe_Expiry(t *testing.T) {
+	type want struct {
+		obs       managed.ExternalObservation
+		err       error
+		methods   []string
+		deleted   bool
+		expired   bool
+		condition xpv1.Condition
+	}
+
+	now := time.Now()
+	lifetime := time.Minute
+
+	cases := map[string]struct {
+		cr     *v1alpha1.PortOrder
+		delete error
+		want   want
+	}{
+		"NotExpired": {
+			cr: portOrder(withLifetime(now, 3600, "")),
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				methods:   []string{http.MethodGet},
+				condition: xpv1.Available(),
+			},
+		},
+		"Expires": {
+			cr: portOrder(withLifetime(now.Add(-time.Hour), 60, "")),
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				methods:   []string{http.MethodDelete},
+				expired:   true,
+				condition: v1alpha1.Expired(lifetime),
+			},
+		},
+		"ExpiredDeleted": {
+			cr: portOrder(withLifetime(now.Add(-time.Hour), 60, v1alpha1.ExpiryActionDelete), withExpired),
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				deleted:   true,
+				expired:   true,
+				condition: v1alpha1.Expired(lifetime),
+			},
+		},
+		"ExpiredDeleteFailed": {
+			cr:     portOrder(withLifetime(now.Add(-time.Hour), 60, ""), withExpired),
+			delete: errBoom,
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				err:       errors.Wrap(errBoom, errDeleteExpired),
+				deleted:   true,
+				expired:   true,
+				condition: v1alpha1.Expired(lifetime),
+			},
+		},
+		"ExpiredKept": {
+			cr: portOrder(withLifetime(now.Add(-time.Hour), 60, v1alpha1.ExpiryActionCancel), withExpired),
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				expired:   true,
+				condition: v1alpha1.Expired(lifetime),
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			var methods []string
+			deleted := false
+			e := &external{
+				kube: &test.MockClient{MockDelete: func(_ context.Context, _ client.Object, _ ...client.DeleteOption) error {
+					deleted = true
+					return tc.delete
+				}},
+				client: &MockHttpClient{MockSendRequest: func(ctx context.Context, method string, url string, body httpclient.Data, headers httpclient.Data, skip bool) (httpclient.HttpDetails, error) {
+					methods = append(methods, method)
+					return respondWith(200, `{"orderId":"order-123","status":"active","source":"10.0.0.0/24","destination":"10.0.1.0/24"}`)(ctx, method, url, body, headers, skip)
+				}},
+				logger: logging.NewNopLogger(),
+			}
+
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.Observe(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("e.Observe(...): -want, +got: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.methods, methods); diff != "" {
+				t.Errorf("e.Observe(...): -want methods, +got methods: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("e.Observe(...): -want deleted, +got deleted: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.expired, tc.cr.Status.AtProvider.ExpiredTime != nil); diff != "" {
+				t.Errorf("e.Observe(...): -want expired, +got expired: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, tc.cr.GetCondition(xpv1.TypeReady), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("e.Observe(...): -want Ready condition, +got Ready condition: %s", diff)
+			}
+		})
+	}
+}
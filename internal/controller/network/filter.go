@@ -0,0 +1,51 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// eventFilter returns the filter of the PortOrder watch events that trigger
+// a reconcile. Only changes of the desired state pass it, so that status
+// updates do not cause another reconcile. If observeOnResync is set, the
+// periodic resync of the cache passes it too, so that every PortOrder is
+// observed at least once per sync interval.
+func eventFilter(observeOnResync bool) predicate.Predicate {
+	if !observeOnResync {
+		return resource.DesiredStateChanged()
+	}
+	return predicate.Or(resource.DesiredStateChanged(), resynced())
+}
+
+// resynced accepts the update events of a cache resync. They are the only
+// updates in which the object is unchanged, including its resource version;
+// any other update, status updates included, changes the resource version.
+func resynced() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return e.ObjectOld != nil && e.ObjectNew != nil &&
+				e.ObjectOld.GetResourceVersion() == e.ObjectNew.GetResourceVersion()
+		},
+	}
+}
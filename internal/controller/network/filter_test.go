@@ -0,0 +1,75 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+func Test_eventFilter(t *testing.T) {
+	version := func(resourceVersion string, generation int64) *v1alpha1.PortOrder {
+		return portOrder(func(po *v1alpha1.PortOrder) {
+			po.SetResourceVersion(resourceVersion)
+			po.SetGeneration(generation)
+		})
+	}
+
+	type want struct {
+		desiredStateOnly bool
+		observeOnResync  bool
+	}
+
+	cases := map[string]struct {
+		old  *v1alpha1.PortOrder
+		new  *v1alpha1.PortOrder
+		want want
+	}{
+		"SpecChanged": {
+			old:  version("1", 1),
+			new:  version("2", 2),
+			want: want{desiredStateOnly: true, observeOnResync: true},
+		},
+		"StatusChanged": {
+			old: version("1", 1),
+			new: version("2", 1),
+		},
+		"Resync": {
+			old:  version("2", 1),
+			new:  version("2", 1),
+			want: want{observeOnResync: true},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			e := event.UpdateEvent{ObjectOld: tc.old, ObjectNew: tc.new}
+			got := want{
+				desiredStateOnly: eventFilter(false).Update(e),
+				observeOnResync:  eventFilter(true).Update(e),
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Fatalf("eventFilter(...).Update(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
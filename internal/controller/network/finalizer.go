@@ -0,0 +1,80 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"slices"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const errUpdateFinalizers = "cannot update PortOrder finalizers"
+
+// migratingFinalizer manages the configured finalizer of a PortOrder. The
+// default managed resource finalizer and any legacy finalizers, i.e. the
+// names the finalizer was configured with before, are removed together with
+// it, so that PortOrders created before the name was changed can still be
+// deleted.
+type migratingFinalizer struct {
+	kube   client.Client
+	name   string
+	legacy []string
+}
+
+func newFinalizer(kube client.Client, name string, legacy ...string) resource.Finalizer {
+	if name == "" {
+		name = managed.FinalizerName
+	}
+	f := &migratingFinalizer{kube: kube, name: name}
+	for _, l := range append([]string{managed.FinalizerName}, legacy...) {
+		if l != "" && l != name && !slices.Contains(f.legacy, l) {
+			f.legacy = append(f.legacy, l)
+		}
+	}
+	return f
+}
+
+// AddFinalizer adds the configured finalizer to the PortOrder.
+func (f *migratingFinalizer) AddFinalizer(ctx context.Context, obj resource.Object) error {
+	if meta.FinalizerExists(obj, f.name) {
+		return nil
+	}
+	meta.AddFinalizer(obj, f.name)
+	return errors.Wrap(f.kube.Update(ctx, obj), errUpdateFinalizers)
+}
+
+// RemoveFinalizer removes the configured and the legacy finalizers from the
+// PortOrder.
+func (f *migratingFinalizer) RemoveFinalizer(ctx context.Context, obj resource.Object) error {
+	changed := false
+	for _, name := range append([]string{f.name}, f.legacy...) {
+		if meta.FinalizerExists(obj, name) {
+			meta.RemoveFinalizer(obj, name)
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return errors.Wrap(resource.IgnoreNotFound(f.kube.Update(ctx, obj)), errUpdateFinalizers)
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+const (
+	testFinalizer       = "portorder.example.com/finalizer"
+	testLegacyFinalizer = "portorder.example.com/legacy"
+)
+
+func Test_migratingFinalizer(t *testing.T) {
+	type want struct {
+		finalizers []string
+		updated    bool
+	}
+
+	cases := map[string]struct {
+		name       string
+		legacy     []string
+		remove     bool
+		finalizers []string
+		want       want
+	}{
+		"Add": {
+			finalizers: []string{"other"},
+			want:       want{finalizers: []string{"other", testFinalizer}, updated: true},
+		},
+		"AddKeepsLegacy": {
+			finalizers: []string{managed.FinalizerName},
+			want:       want{finalizers: []string{managed.FinalizerName, testFinalizer}, updated: true},
+		},
+		"AddExisting": {
+			finalizers: []string{testFinalizer},
+			want:       want{finalizers: []string{testFinalizer}},
+		},
+		"RemoveBoth": {
+			remove:     true,
+			finalizers: []string{managed.FinalizerName, "other", testFinalizer},
+			want:       want{finalizers: []string{"other"}, updated: true},
+		},
+		"RemoveLegacyOnly": {
+			remove:     true,
+			finalizers: []string{managed.FinalizerName},
+			want:       want{finalizers: []string{}, updated: true},
+		},
+		"RemoveRenamed": {
+			legacy:     []string{testLegacyFinalizer},
+			remove:     true,
+			finalizers: []string{testLegacyFinalizer, "other"},
+			want:       want{finalizers: []string{"other"}, updated: true},
+		},
+		"RemoveCustomAfterDefaultRestored": {
+			name:       managed.FinalizerName,
+			legacy:     []string{testFinalizer},
+			remove:     true,
+			finalizers: []string{testFinalizer},
+			want:       want{finalizers: []string{}, updated: true},
+		},
+		"AddDefaultKeepsCustom": {
+			name:       managed.FinalizerName,
+			legacy:     []string{testFinalizer},
+			finalizers: []string{testFinalizer},
+			want:       want{finalizers: []string{testFinalizer, managed.FinalizerName}, updated: true},
+		},
+		"RemoveNone": {
+			remove:     true,
+			finalizers: []string{"other"},
+			want:       want{finalizers: []string{"other"}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			updated := false
+			kube := &test.MockClient{
+				MockUpdate: func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+					updated = true
+					return nil
+				},
+			}
+
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.SetFinalizers(tc.finalizers)
+			})
+			name := tc.name
+			if name == "" {
+				name = testFinalizer
+			}
+			f := newFinalizer(kube, name, tc.legacy...)
+
+			var err error
+			if tc.remove {
+				err = f.RemoveFinalizer(context.Background(), cr)
+			} else {
+				err = f.AddFinalizer(context.Background(), cr)
+			}
+			if err != nil {
+				t.Fatalf("finalizer: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.finalizers, cr.GetFinalizers()); diff != "" {
+				t.Errorf("finalizers: -want, +got: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("updated: -want, +got: %s", diff)
+			}
+		})
+	}
+}
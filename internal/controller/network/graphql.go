@@ -0,0 +1,152 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+const (
+	errGraphQL = "GraphQL request failed: %s"
+
+	defaultCreateMutation = `mutation CreateOrder($order: OrderInput!) {
+  order: createOrder(order: $order) { orderId status }
+}`
+	defaultObserveQuery = `query Order($id: ID!) {
+  order(id: $id) { orderId status }
+}`
+	defaultCancelMutation = `mutation CancelOrder($id: ID!) {
+  order: cancelOrder(id: $id) { orderId status }
+}`
+)
+
+// graphQLRequest is the body of a GraphQL request.
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// graphQLResponse is the body of a GraphQL response.
+type graphQLResponse struct {
+	Data *struct {
+		Order *OrderResponse `json:"order"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors,omitempty"`
+}
+
+func isGraphQL(p v1alpha1.PortOrderParameters) bool {
+	return p.Protocol == v1alpha1.ProtocolGraphQL
+}
+
+// createMutation returns the GraphQL mutation that creates the order.
+func createMutation(p v1alpha1.PortOrderParameters) string {
+	if p.GraphQL != nil && p.GraphQL.CreateMutation != "" {
+		return p.GraphQL.CreateMutation
+	}
+	return defaultCreateMutation
+}
+
+// observeQuery returns the GraphQL query that reads the order.
+func observeQuery(p v1alpha1.PortOrderParameters) string {
+	if p.GraphQL != nil && p.GraphQL.ObserveQuery != "" {
+		return p.GraphQL.ObserveQuery
+	}
+	return defaultObserveQuery
+}
+
+// cancelMutation returns the GraphQL mutation that cancels the order.
+func cancelMutation(p v1alpha1.PortOrderParameters) string {
+	if p.GraphQL != nil && p.GraphQL.CancelMutation != "" {
+		return p.GraphQL.CancelMutation
+	}
+	return defaultCancelMutation
+}
+
+// sendGraphQL posts a GraphQL operation to the endpoint.
+func (e *external) sendGraphQL(ctx context.Context, endpoint, query string, variables map[string]interface{}, headers map[string][]string) (httpclient.HttpDetails, error) {
+	body, err := json.Marshal(graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return httpclient.HttpDetails{}, errors.Wrap(err, errMarshal)
+	}
+
+	h := make(map[string][]string, len(headers)+1)
+	for k, v := range headers {
+		h[k] = v
+	}
+	h["Content-Type"] = []string{"application/json"}
+
+	return e.sendRequest(ctx, isMutation(query), http.MethodPost, endpoint, string(body), h)
+}
+
+// decodeOrder decodes a REST response body into the supplied order. Fields
+// absent from the body are left as they are.
+func decodeOrder(p v1alpha1.PortOrderParameters, body string, resp *OrderResponse) error {
+	if err := decodeJSON(body, resp); err != nil {
+		return errors.Wrap(err, errUnmarshal)
+	}
+	if p.StatusPath != "" && p.StatusPath != defaultStatusPath {
+		status, err := orderStatus(body, p.StatusPath)
+		if err != nil {
+			return err
+		}
+		if status != "" {
+			resp.Status = status
+		}
+	}
+	return nil
+}
+
+// parseOrder reads the order from a response body. It returns nil if a
+// GraphQL response does not contain the order.
+func parseOrder(p v1alpha1.PortOrderParameters, body string) (*OrderResponse, error) {
+	if !isGraphQL(p) {
+		var resp OrderResponse
+		if err := decodeOrder(p, body, &resp); err != nil {
+			return nil, err
+		}
+		return &resp, nil
+	}
+
+	var resp graphQLResponse
+	if err := decodeJSON(body, &resp); err != nil {
+		return nil, errors.Wrap(err, errUnmarshal)
+	}
+
+	// GraphQL reports failures in the body of a successful response.
+	if len(resp.Errors) > 0 {
+		msgs := make([]string, 0, len(resp.Errors))
+		for _, e := range resp.Errors {
+			msgs = append(msgs, e.Message)
+		}
+		return nil, errors.Errorf(errGraphQL, strings.Join(msgs, "; "))
+	}
+
+	if resp.Data == nil {
+		return nil, nil
+	}
+	return resp.Data.Order, nil
+}
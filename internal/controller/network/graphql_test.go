@@ -0,0 +1,156 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+func withGraphQL(po *v1alpha1.PortOrder) {
+	po.Spec.ForProvider.Protocol = v1alpha1.ProtocolGraphQL
+}
+
+func Test_parseOrder(t *testing.T) {
+	type want struct {
+		order *OrderResponse
+		err   error
+	}
+
+	cases := map[string]struct {
+		protocol   string
+		statusPath string
+		body       string
+		want       want
+	}{
+		"REST": {
+			body: `{"orderId":"order-123","status":"pending"}`,
+			want: want{order: &OrderResponse{OrderID: jsonIDs{"order-123"}, Status: "pending"}},
+		},
+		"RESTNumericIDBeyondFloat64Precision": {
+			body: `{"orderId":9007199254740993,"status":"pending"}`,
+			want: want{order: &OrderResponse{OrderID: jsonIDs{"9007199254740993"}, Status: "pending"}},
+		},
+		"RESTNestedStatus": {
+			statusPath: "order.state.phase",
+			body:       `{"orderId":"order-123","status":"accepted","order":{"state":{"phase":"provisioning","since":"2025-01-01"}}}`,
+			want:       want{order: &OrderResponse{OrderID: jsonIDs{"order-123"}, Status: "provisioning"}},
+		},
+		"RESTNestedStatusMissing": {
+			statusPath: "order.state.phase",
+			body:       `{"orderId":"order-123","order":{"state":{}}}`,
+			want:       want{order: &OrderResponse{OrderID: jsonIDs{"order-123"}}},
+		},
+		"RESTDefaultStatusPath": {
+			statusPath: "status",
+			body:       `{"orderId":"order-123","status":"pending"}`,
+			want:       want{order: &OrderResponse{OrderID: jsonIDs{"order-123"}, Status: "pending"}},
+		},
+		"GraphQL": {
+			protocol: v1alpha1.ProtocolGraphQL,
+			body:     `{"data":{"order":{"orderId":"order-123","status":"active"}}}`,
+			want:     want{order: &OrderResponse{OrderID: jsonIDs{"order-123"}, Status: "active"}},
+		},
+		"GraphQLNumericID": {
+			protocol: v1alpha1.ProtocolGraphQL,
+			body:     `{"data":{"order":{"orderId":18446744073709551615,"status":"active"}}}`,
+			want:     want{order: &OrderResponse{OrderID: jsonIDs{"18446744073709551615"}, Status: "active"}},
+		},
+		"GraphQLNullOrder": {
+			protocol: v1alpha1.ProtocolGraphQL,
+			body:     `{"data":{"order":null}}`,
+		},
+		"GraphQLErrors": {
+			protocol: v1alpha1.ProtocolGraphQL,
+			body:     `{"data":null,"errors":[{"message":"port denied"},{"message":"quota exceeded"}]}`,
+			want:     want{err: errors.Errorf(errGraphQL, "port denied; quota exceeded")},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got, err := parseOrder(v1alpha1.PortOrderParameters{Protocol: tc.protocol, StatusPath: tc.statusPath}, tc.body)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("parseOrder(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.order, got); diff != "" {
+				t.Fatalf("parseOrder(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_GraphQL(t *testing.T) {
+	var got []graphQLRequest
+	e := &external{
+		client: &MockHttpClient{
+			MockSendRequest: func(_ context.Context, method string, _ string, body httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+				var req graphQLRequest
+				if err := json.Unmarshal([]byte(body.Decrypted.(string)), &req); err != nil {
+					t.Fatalf("GraphQL request body: %v", err)
+				}
+				got = append(got, req)
+				return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{
+					StatusCode: 200,
+					Body:       `{"data":{"order":{"orderId":"order-123","status":"pending"}}}`,
+				}}, nil
+			},
+		},
+		logger: logging.NewNopLogger(),
+	}
+
+	cr := portOrder(withGraphQL, func(po *v1alpha1.PortOrder) {
+		po.Spec.ForProvider.GraphQL = &v1alpha1.GraphQLParameters{ObserveQuery: "query { order(id: $id) { status } }"}
+	})
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("e.Create(...): %v", err)
+	}
+	if diff := cmp.Diff("order-123", meta.GetExternalName(cr)); diff != "" {
+		t.Fatalf("e.Create(...): -want external name, +got external name: %s", diff)
+	}
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("GraphQL requests: want 2, got %d", len(got))
+	}
+	if diff := cmp.Diff(defaultCreateMutation, got[0].Query); diff != "" {
+		t.Errorf("create mutation: -want, +got: %s", diff)
+	}
+	if _, ok := got[0].Variables["order"]; !ok {
+		t.Errorf("create mutation: want $order variable, got %v", got[0].Variables)
+	}
+	if diff := cmp.Diff("query { order(id: $id) { status } }", got[1].Query); diff != "" {
+		t.Errorf("observe query: -want, +got: %s", diff)
+	}
+	if diff := cmp.Diff(map[string]interface{}{"id": "order-123"}, got[1].Variables); diff != "" {
+		t.Errorf("observe query variables: -want, +got: %s", diff)
+	}
+}
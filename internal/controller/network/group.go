@@ -0,0 +1,151 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+const (
+	errListGroup        = "cannot list PortOrders of group %s"
+	errUpdateGroupLabel = "cannot update PortOrder group label"
+
+	// groupIDHeader carries the GroupID of an order to the backend.
+	groupIDHeader = "X-Order-Group"
+)
+
+// pendingGroupMembers returns the names of the other orders of the group of
+// the supplied order that have not succeeded yet. Orders of a group are found
+// by their group label, which is set by groupLabeler.
+func pendingGroupMembers(ctx context.Context, kube client.Client, cr *v1alpha1.PortOrder) ([]string, error) {
+	group := cr.Spec.ForProvider.GroupID
+	l := &v1alpha1.PortOrderList{}
+	if err := kube.List(ctx, l, client.MatchingLabels{v1alpha1.LabelKeyGroup: group}); err != nil {
+		return nil, errors.Wrapf(err, errListGroup, group)
+	}
+
+	var pending []string
+	for i := range l.Items {
+		member := &l.Items[i]
+		if member.GetName() == cr.GetName() {
+			continue
+		}
+		if !isReady(member) {
+			pending = append(pending, member.GetName())
+		}
+	}
+	sort.Strings(pending)
+	return pending, nil
+}
+
+// groupLabeler is a managed resource initializer that keeps the group label
+// of a PortOrder in sync with its GroupID. Initializers run before every
+// observation, so an order is labeled before it is first created.
+type groupLabeler struct {
+	kube client.Client
+}
+
+// Initialize updates the group label of the PortOrder, if it changed.
+func (g *groupLabeler) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.PortOrder)
+	if !ok {
+		return errors.New(errNotPortOrder)
+	}
+	if !syncGroupLabel(cr) {
+		return nil
+	}
+	return errors.Wrap(g.kube.Update(ctx, cr), errUpdateGroupLabel)
+}
+
+// syncGroupLabel sets the group label of the order to its GroupID, or removes
+// it if the order has none, and reports whether the label changed.
+func syncGroupLabel(cr *v1alpha1.PortOrder) bool {
+	group := cr.Spec.ForProvider.GroupID
+	current, ok := cr.GetLabels()[v1alpha1.LabelKeyGroup]
+	switch {
+	case group == "" && ok:
+		meta.RemoveLabels(cr, v1alpha1.LabelKeyGroup)
+		return true
+	case group != "" && current != group:
+		meta.AddLabels(cr, map[string]string{v1alpha1.LabelKeyGroup: group})
+		return true
+	default:
+		return false
+	}
+}
+
+// setReadiness records whether the order is ready. An order that succeeded is
+// not ready while other orders of its group have not.
+func (e *external) setReadiness(ctx context.Context, cr *v1alpha1.PortOrder) error {
+	if isFailed(cr) {
+		cr.SetConditions(withNextAction(v1alpha1.OrderFailed(cr.Status.AtProvider.Status, cr.Status.AtProvider.StatusReason), cr))
+		return nil
+	}
+	if !isReady(cr) {
+		cr.SetConditions(withNextAction(xpv1.Unavailable(), cr))
+		return nil
+	}
+
+	if cr.Spec.ForProvider.GroupID != "" {
+		pending, err := pendingGroupMembers(ctx, e.kube, cr)
+		if err != nil {
+			return err
+		}
+		if len(pending) > 0 {
+			cr.SetConditions(v1alpha1.WaitingForGroup(pending))
+			return nil
+		}
+	}
+
+	setAvailable(cr, time.Now())
+	return nil
+}
+
+// setAvailable marks the order as ready, and records when it first became
+// ready unless it was before.
+func setAvailable(cr *v1alpha1.PortOrder, now time.Time) {
+	cr.SetConditions(xpv1.Available())
+	if cr.Status.AtProvider.ReadyAt == nil {
+		t := metav1.NewTime(now)
+		cr.Status.AtProvider.ReadyAt = &t
+	}
+}
+
+// withNextAction adds the action the backend reports is needed to progress
+// the order, if any, to the message of the condition.
+func withNextAction(c xpv1.Condition, cr *v1alpha1.PortOrder) xpv1.Condition {
+	next := cr.Status.AtProvider.NextAction
+	if next == "" {
+		return c
+	}
+	if c.Message == "" {
+		return c.WithMessage("next action: " + next)
+	}
+	return c.WithMessage(c.Message + "; next action: " + next)
+}
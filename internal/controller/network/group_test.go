@@ -0,0 +1,283 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+const testGroupID = "change-42"
+
+func groupOrder(name, status string) *v1alpha1.PortOrder {
+	return portOrder(func(po *v1alpha1.PortOrder) {
+		po.SetName(name)
+		po.SetLabels(map[string]string{v1alpha1.LabelKeyGroup: testGroupID})
+		po.Spec.ForProvider.GroupID = testGroupID
+		po.Status.AtProvider.Status = status
+	})
+}
+
+// withGroup returns the supplied orders when listing the test group.
+func withGroup(orders ...*v1alpha1.PortOrder) test.MockListFn {
+	return func(_ context.Context, obj client.ObjectList, opts ...client.ListOption) error {
+		lo := &client.ListOptions{}
+		lo.ApplyOptions(opts)
+		if lo.LabelSelector == nil || lo.LabelSelector.String() != v1alpha1.LabelKeyGroup+"="+testGroupID {
+			return errors.Errorf("unexpected label selector %v", lo.LabelSelector)
+		}
+		l := obj.(*v1alpha1.PortOrderList)
+		for _, o := range orders {
+			l.Items = append(l.Items, *o)
+		}
+		return nil
+	}
+}
+
+func Test_external_setReadiness(t *testing.T) {
+	type want struct {
+		condition xpv1.Condition
+		err       error
+	}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.PortOrder
+		list test.MockListFn
+		want want
+	}{
+		"NotReady": {
+			cr:   namedOrder("a", "pending"),
+			want: want{condition: xpv1.Unavailable()},
+		},
+		"Failed": {
+			cr:   namedOrder("a", "Rejected"),
+			want: want{condition: v1alpha1.OrderFailed("Rejected", "")},
+		},
+		"FailedWithReason": {
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.Status = "rejected"
+				po.Status.AtProvider.StatusReason = "destination is not routable"
+			}),
+			want: want{condition: xpv1.Condition{
+				Type:    xpv1.TypeReady,
+				Status:  corev1.ConditionFalse,
+				Reason:  v1alpha1.ReasonOrderFailed,
+				Message: "order failed with status rejected: destination is not routable",
+			}},
+		},
+		"NotReadyWithNextAction": {
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.Status = "pending"
+				po.Status.AtProvider.NextAction = "awaiting-approval-from-netsec"
+			}),
+			want: want{condition: xpv1.Unavailable().WithMessage("next action: awaiting-approval-from-netsec")},
+		},
+		"FailedWithReasonAndNextAction": {
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.Status = "rejected"
+				po.Status.AtProvider.StatusReason = "destination is not routable"
+				po.Status.AtProvider.NextAction = "resubmit-with-routable-destination"
+			}),
+			want: want{condition: xpv1.Condition{
+				Type:    xpv1.TypeReady,
+				Status:  corev1.ConditionFalse,
+				Reason:  v1alpha1.ReasonOrderFailed,
+				Message: "order failed with status rejected: destination is not routable; next action: resubmit-with-routable-destination",
+			}},
+		},
+		"CustomReadyStatus": {
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.ReadyStatuses = []string{"applied"}
+				po.Status.AtProvider.Status = "Applied"
+			}),
+			want: want{condition: xpv1.Available()},
+		},
+		"DefaultReadyStatusOverridden": {
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.ReadyStatuses = []string{"applied"}
+				po.Status.AtProvider.Status = "active"
+			}),
+			want: want{condition: xpv1.Unavailable()},
+		},
+		"ReadyWithoutGroup": {
+			cr:   namedOrder("a", "active"),
+			want: want{condition: xpv1.Available()},
+		},
+		"GroupPending": {
+			cr: groupOrder("a", "active"),
+			list: withGroup(
+				groupOrder("a", "pending"),
+				groupOrder("c", "pending"),
+				groupOrder("b", "failed"),
+				groupOrder("d", "complete"),
+			),
+			want: want{condition: v1alpha1.WaitingForGroup([]string{"b", "c"})},
+		},
+		"GroupReady": {
+			cr:   groupOrder("a", "active"),
+			list: withGroup(groupOrder("a", "pending"), groupOrder("b", "active")),
+			want: want{condition: xpv1.Available()},
+		},
+		"ListFailed": {
+			cr:   groupOrder("a", "active"),
+			list: test.NewMockListFn(errBoom),
+			want: want{err: errors.Wrapf(errBoom, errListGroup, testGroupID)},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: &test.MockClient{MockList: tc.list}, logger: logging.NewNopLogger()}
+			err := e.setReadiness(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.setReadiness(...): -want error, +got error: %s", diff)
+			}
+			if err != nil {
+				return
+			}
+			got := tc.cr.GetCondition(xpv1.TypeReady)
+			if diff := cmp.Diff(tc.want.condition, got, cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Fatalf("e.setReadiness(...): -want condition, +got condition: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Observe_ReadyAt(t *testing.T) {
+	earlier := metav1.NewTime(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	cases := map[string]struct {
+		status  string
+		readyAt *metav1.Time
+		want    func(got *metav1.Time) bool
+	}{
+		"NotReady": {
+			status: "pending",
+			want:   func(got *metav1.Time) bool { return got == nil },
+		},
+		"FirstReady": {
+			status: "active",
+			want:   func(got *metav1.Time) bool { return got != nil && time.Since(got.Time) < time.Minute },
+		},
+		"StillReady": {
+			status:  "active",
+			readyAt: &earlier,
+			want:    func(got *metav1.Time) bool { return got != nil && got.Equal(&earlier) },
+		},
+		"NoLongerReady": {
+			status:  "pending",
+			readyAt: &earlier,
+			want:    func(got *metav1.Time) bool { return got != nil && got.Equal(&earlier) },
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.OrderID = testOrderID
+				po.Status.AtProvider.ReadyAt = tc.readyAt
+			})
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: respondWith(200, `{"orderId":"order-123","status":"`+tc.status+`"}`)},
+				logger: logging.NewNopLogger(),
+			}
+
+			if _, err := e.Observe(context.Background(), cr); err != nil {
+				t.Fatalf("e.Observe(...): unexpected error: %v", err)
+			}
+			if got := cr.Status.AtProvider.ReadyAt; !tc.want(got) {
+				t.Errorf("e.Observe(...): unexpected ReadyAt %v", got)
+			}
+		})
+	}
+}
+
+func Test_groupLabeler_Initialize(t *testing.T) {
+	type want struct {
+		labels  map[string]string
+		updated bool
+	}
+
+	cases := map[string]struct {
+		groupID string
+		labels  map[string]string
+		want    want
+	}{
+		"Labeled": {
+			groupID: testGroupID,
+			want:    want{labels: map[string]string{v1alpha1.LabelKeyGroup: testGroupID}, updated: true},
+		},
+		"Relabeled": {
+			groupID: testGroupID,
+			labels:  map[string]string{v1alpha1.LabelKeyGroup: "change-41", "team": "netsec"},
+			want:    want{labels: map[string]string{v1alpha1.LabelKeyGroup: testGroupID, "team": "netsec"}, updated: true},
+		},
+		"AlreadyLabeled": {
+			groupID: testGroupID,
+			labels:  map[string]string{v1alpha1.LabelKeyGroup: testGroupID},
+			want:    want{labels: map[string]string{v1alpha1.LabelKeyGroup: testGroupID}},
+		},
+		"LeftGroup": {
+			labels: map[string]string{v1alpha1.LabelKeyGroup: testGroupID, "team": "netsec"},
+			want:   want{labels: map[string]string{"team": "netsec"}, updated: true},
+		},
+		"NoGroup": {},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			updated := false
+			kube := &test.MockClient{
+				MockUpdate: func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+					updated = true
+					return nil
+				},
+			}
+
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.SetLabels(tc.labels)
+				po.Spec.ForProvider.GroupID = tc.groupID
+			})
+			if err := (&groupLabeler{kube: kube}).Initialize(context.Background(), cr); err != nil {
+				t.Fatalf("Initialize(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want.labels, cr.GetLabels(), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("labels: -want, +got: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("updated: -want, +got: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errAddHealthProbe   = "cannot add orders backend health probe"
+	errHealthNotProbed  = "orders backend was not probed yet"
+	errHealthProbe      = "cannot reach orders backend"
+	errHealthStatusCode = "orders backend health endpoint returned status code %d"
+	errHealthProbeStale = "orders backend was last probed %s ago"
+)
+
+// healthCheckName is the name of the readiness check of the orders backend.
+const healthCheckName = "orders-backend"
+
+const (
+	defaultHealthInterval = 30 * time.Second
+	// maxHealthTimeout limits how long a probe waits for the backend, even
+	// if the interval is longer.
+	maxHealthTimeout = 10 * time.Second
+)
+
+// backendProbe periodically probes whether the orders backend is reachable,
+// and caches the result for the readiness check of the controller, so that
+// the check neither waits for nor loads the backend. The probe is a plain GET
+// of a health endpoint; any response but a server error means the backend is
+// reachable, as the endpoint need not accept unauthenticated requests.
+type backendProbe struct {
+	url      string
+	interval time.Duration
+	client   *http.Client
+
+	mu      sync.RWMutex
+	err     error
+	checked time.Time
+}
+
+// newBackendProbe returns a probe of the supplied health endpoint of the
+// orders backend, sent at the supplied interval or every 30 seconds if it is
+// not positive.
+func newBackendProbe(url string, interval time.Duration) *backendProbe {
+	if interval <= 0 {
+		interval = defaultHealthInterval
+	}
+	return &backendProbe{
+		url:      url,
+		interval: interval,
+		client:   &http.Client{Timeout: min(interval, maxHealthTimeout)},
+		err:      errors.New(errHealthNotProbed),
+	}
+}
+
+// Start probes the backend at the interval of the probe until ctx is done.
+func (p *backendProbe) Start(ctx context.Context) error {
+	t := time.NewTicker(p.interval)
+	defer t.Stop()
+	for {
+		p.probe(ctx, time.Now())
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+// NeedLeaderElection is false, since every replica of the controller reports
+// its own readiness.
+func (p *backendProbe) NeedLeaderElection() bool {
+	return false
+}
+
+// probe sends the probe once and caches its result.
+func (p *backendProbe) probe(ctx context.Context, now time.Time) {
+	err := p.get(ctx)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.err, p.checked = err, now
+}
+
+func (p *backendProbe) get(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url, nil)
+	if err != nil {
+		return errors.Wrap(err, errHealthProbe)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, errHealthProbe)
+	}
+	resp.Body.Close() //nolint:errcheck // only the status code is of interest.
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return errors.Errorf(errHealthStatusCode, resp.StatusCode)
+	}
+	return nil
+}
+
+// result returns the cached result of the last probe, which is an error too
+// if the probe did not run for several intervals.
+func (p *backendProbe) result(now time.Time) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.err != nil {
+		return p.err
+	}
+	if age := now.Sub(p.checked); age > 3*p.interval {
+		return errors.Errorf(errHealthProbeStale, age.Round(time.Second))
+	}
+	return nil
+}
+
+// Check is the readiness check of the orders backend.
+func (p *backendProbe) Check(_ *http.Request) error {
+	return p.result(time.Now())
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func Test_backendProbe_result(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		down   bool
+		probed bool
+		age    time.Duration
+		want   error
+	}{
+		"NotProbed": {
+			want: errors.New(errHealthNotProbed),
+		},
+		"Reachable": {
+			status: http.StatusOK,
+			probed: true,
+		},
+		"Unauthorized": {
+			status: http.StatusUnauthorized,
+			probed: true,
+		},
+		"ServerError": {
+			status: http.StatusServiceUnavailable,
+			probed: true,
+			want:   errors.Errorf(errHealthStatusCode, http.StatusServiceUnavailable),
+		},
+		"Unreachable": {
+			down:   true,
+			probed: true,
+		},
+		"Stale": {
+			status: http.StatusOK,
+			probed: true,
+			age:    2 * time.Minute,
+			want:   errors.Errorf(errHealthProbeStale, 2*time.Minute),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tc.status)
+			}))
+			defer s.Close()
+			if tc.down {
+				s.Close()
+			}
+
+			now := time.Now()
+			p := newBackendProbe(s.URL, 30*time.Second)
+			if tc.probed {
+				p.probe(context.Background(), now.Add(-tc.age))
+			}
+
+			got := p.result(now)
+			if tc.down {
+				// The error of the unreachable server depends on the
+				// platform, so only its wrapping message is compared.
+				if got == nil || !strings.HasPrefix(got.Error(), errHealthProbe) {
+					t.Fatalf("p.result(...): want error %q, got %v", errHealthProbe, got)
+				}
+				return
+			}
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("p.result(...): -want error, +got error: %s", diff)
+			}
+		})
+	}
+}
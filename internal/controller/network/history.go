@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	"github.com/crossplane-contrib/provider-http/internal/utils"
+)
+
+const (
+	errHistory           = "cannot read order history"
+	errHistoryStatusCode = "history endpoint of order %s returned status code %d"
+)
+
+// historyPath is the path of the history endpoint relative to the URL of an
+// order.
+const historyPath = "history"
+
+// historyEntry is a status transition in a history response.
+type historyEntry struct {
+	Status    string `json:"status"`
+	Actor     string `json:"actor,omitempty"`
+	Timestamp string `json:"timestamp,omitempty"`
+}
+
+// readsHistory reports whether the history of the order is read.
+func readsHistory(p v1alpha1.PortOrderParameters) bool {
+	return p.HistoryLimit != nil && *p.HistoryLimit > 0 && !isGraphQL(p)
+}
+
+// historyURL returns the URL of the history endpoint of an order, limited to
+// the supplied number of transitions.
+func historyURL(endpoint, id string, limit int64) string {
+	return fmt.Sprintf("%s/%s?limit=%d", orderURL(endpoint, id), historyPath, limit)
+}
+
+// parseHistory parses a history response, which is either a list of
+// transitions or an object with the list in its history field.
+func parseHistory(body string) ([]historyEntry, error) {
+	var entries []historyEntry
+	if err := decodeJSON(body, &entries); err == nil {
+		return entries, nil
+	}
+	var wrapped struct {
+		History []historyEntry `json:"history"`
+	}
+	if err := decodeJSON(body, &wrapped); err != nil {
+		return nil, err
+	}
+	return wrapped.History, nil
+}
+
+// readHistory reads the most recent status transitions of every rule object
+// of the order, and stores the most recent ones up to the limit of the order,
+// oldest first. Backends that do not have a history endpoint respond with
+// 404, 405 or 501, in which case the history is left as it is.
+func (e *external) readHistory(ctx context.Context, cr *v1alpha1.PortOrder, endpoint string) error {
+	limit := *cr.Spec.ForProvider.HistoryLimit
+	var history []v1alpha1.OrderTransition
+	for _, id := range orderIDs(cr) {
+		details, err := e.send(ctx, http.MethodGet, historyURL(endpoint, id, limit), "", nil)
+		if err != nil {
+			return errors.Wrap(err, errHistory)
+		}
+		switch code := details.HttpResponse.StatusCode; {
+		case code == http.StatusNotFound || code == http.StatusMethodNotAllowed || code == http.StatusNotImplemented:
+			e.logger.Debug("Backend does not support order history", "orderId", id, "statusCode", code)
+			return nil
+		case !utils.IsHTTPSuccess(code):
+			return errors.Errorf(errHistoryStatusCode, id, code)
+		}
+		entries, err := parseHistory(details.HttpResponse.Body)
+		if err != nil {
+			return errors.Wrap(err, errHistory)
+		}
+		for _, h := range entries {
+			history = append(history, v1alpha1.OrderTransition{
+				Status: h.Status,
+				Actor:  h.Actor,
+				Time:   lastModified(h.Timestamp),
+			})
+		}
+	}
+
+	// Transitions are ordered by time if they all have one, since backends
+	// may list them newest first.
+	timed := true
+	for _, h := range history {
+		timed = timed && h.Time != nil
+	}
+	if timed {
+		sort.SliceStable(history, func(i, j int) bool { return history[i].Time.Before(history[j].Time) })
+	}
+	if int64(len(history)) > limit {
+		history = history[int64(len(history))-limit:]
+	}
+	cr.Status.AtProvider.History = history
+	return nil
+}
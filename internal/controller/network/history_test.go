@@ -0,0 +1,144 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+func withHistoryLimit(limit int64) portOrderModifier {
+	return func(po *v1alpha1.PortOrder) {
+		po.Spec.ForProvider.HistoryLimit = ptr.To(limit)
+	}
+}
+
+func transition(status, actor, at string) v1alpha1.OrderTransition {
+	t, _ := time.Parse(time.RFC3339, at)
+	mt := metav1.NewTime(t)
+	return v1alpha1.OrderTransition{Status: status, Actor: actor, Time: &mt}
+}
+
+func Test_external_Observe_History(t *testing.T) {
+	const (
+		submitted = `{"status":"submitted","actor":"alice","timestamp":"2025-01-01T10:00:00Z"}`
+		approved  = `{"status":"approved","actor":"netsec","timestamp":"2025-01-01T11:00:00Z"}`
+		active    = `{"status":"active","actor":"backend","timestamp":"2025-01-01T12:00:00Z"}`
+	)
+
+	type want struct {
+		history []v1alpha1.OrderTransition
+		url     string
+		err     error
+	}
+
+	cases := map[string]struct {
+		cr         *v1alpha1.PortOrder
+		statusCode int
+		body       string
+		want       want
+	}{
+		"Disabled": {
+			cr: portOrder(),
+		},
+		"List": {
+			cr:         portOrder(withHistoryLimit(5)),
+			statusCode: http.StatusOK,
+			body:       "[" + submitted + "," + approved + "]",
+			want: want{
+				history: []v1alpha1.OrderTransition{
+					transition("submitted", "alice", "2025-01-01T10:00:00Z"),
+					transition("approved", "netsec", "2025-01-01T11:00:00Z"),
+				},
+				url: testEndpoint + "/" + testOrderID + "/history?limit=5",
+			},
+		},
+		"WrappedNewestFirstLimited": {
+			cr:         portOrder(withHistoryLimit(2)),
+			statusCode: http.StatusOK,
+			body:       `{"history":[` + active + "," + approved + "," + submitted + "]}",
+			want: want{
+				history: []v1alpha1.OrderTransition{
+					transition("approved", "netsec", "2025-01-01T11:00:00Z"),
+					transition("active", "backend", "2025-01-01T12:00:00Z"),
+				},
+				url: testEndpoint + "/" + testOrderID + "/history?limit=2",
+			},
+		},
+		"Unsupported": {
+			cr: portOrder(withHistoryLimit(5), func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.History = []v1alpha1.OrderTransition{{Status: "submitted"}}
+			}),
+			statusCode: http.StatusNotFound,
+			want: want{
+				history: []v1alpha1.OrderTransition{{Status: "submitted"}},
+				url:     testEndpoint + "/" + testOrderID + "/history?limit=5",
+			},
+		},
+		"ServerError": {
+			cr:         portOrder(withHistoryLimit(5)),
+			statusCode: http.StatusInternalServerError,
+			want: want{
+				url: testEndpoint + "/" + testOrderID + "/history?limit=5",
+				err: errors.Errorf(errHistoryStatusCode, testOrderID, http.StatusInternalServerError),
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			tc.cr.Status.AtProvider.OrderID = testOrderID
+			var gotURL string
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: func(ctx context.Context, method string, url string, body httpclient.Data, headers httpclient.Data, skip bool) (httpclient.HttpDetails, error) {
+					if strings.Contains(url, "/history") {
+						gotURL = url
+						return respondWith(tc.statusCode, tc.body)(ctx, method, url, body, headers, skip)
+					}
+					return respondWith(http.StatusOK, `{"orderId":"order-123","status":"active"}`)(ctx, method, url, body, headers, skip)
+				}},
+				logger: logging.NewNopLogger(),
+			}
+
+			_, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.Observe(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.url, gotURL); diff != "" {
+				t.Errorf("e.Observe(...): -want history URL, +got history URL: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.history, tc.cr.Status.AtProvider.History); diff != "" {
+				t.Errorf("e.Observe(...): -want History, +got History: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+const errImportNotFound = "order %s named by the external name of the PortOrder was not found by the backend"
+
+// importedOrderID returns the ID of an existing backend order that a
+// PortOrder which has not recorded an order yet adopts, read from its
+// external name. The managed reconciler defaults the external name to the
+// name of the PortOrder, which does not name an order.
+func importedOrderID(cr *v1alpha1.PortOrder) string {
+	if cr.Status.AtProvider.OrderID != "" {
+		return ""
+	}
+	if name := meta.GetExternalName(cr); name != cr.GetName() {
+		return name
+	}
+	return ""
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+func withExternalName(name string) portOrderModifier {
+	return func(po *v1alpha1.PortOrder) {
+		meta.SetExternalName(po, name)
+	}
+}
+
+func Test_importedOrderID(t *testing.T) {
+	cases := map[string]struct {
+		cr   *v1alpha1.PortOrder
+		want string
+	}{
+		"NoExternalName": {
+			cr: portOrder(),
+		},
+		"DefaultExternalName": {
+			cr: portOrder(withExternalName(testPortOrderName)),
+		},
+		"OrderExternalName": {
+			cr:   portOrder(withExternalName(testOrderID)),
+			want: testOrderID,
+		},
+		"AlreadyRecorded": {
+			cr: portOrder(withExternalName(testOrderID), func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.OrderID = testOrderID
+			}),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, importedOrderID(tc.cr)); diff != "" {
+				t.Fatalf("importedOrderID(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Observe_Import(t *testing.T) {
+	type want struct {
+		obs     managed.ExternalObservation
+		err     error
+		orderID string
+		status  string
+		urls    []string
+	}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.PortOrder
+		code int
+		body string
+		want want
+	}{
+		"Adopted": {
+			cr:   portOrder(withExternalName(testOrderID)),
+			code: 200,
+			body: `{"orderId":"order-123","status":"active","source":"10.0.0.0/24","destination":"10.0.1.0/24"}`,
+			want: want{
+				obs:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				orderID: testOrderID,
+				status:  "active",
+				urls:    []string{testEndpoint + "/" + testOrderID},
+			},
+		},
+		"AdoptedCreateOnly": {
+			cr: portOrder(withExternalName(testOrderID), func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.ObserveMode = v1alpha1.ObserveModeCreateOnly
+			}),
+			code: 200,
+			body: `{"orderId":"order-123","status":"active","source":"10.0.0.0/24","destination":"10.0.1.0/24"}`,
+			want: want{
+				obs:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				orderID: testOrderID,
+				status:  "active",
+				urls:    []string{testEndpoint + "/" + testOrderID},
+			},
+		},
+		"NotFound": {
+			cr:   portOrder(withExternalName(testOrderID)),
+			code: 404,
+			want: want{
+				err:  errors.Errorf(errImportNotFound, testOrderID),
+				urls: []string{testEndpoint + "/" + testOrderID},
+			},
+		},
+		"NotImported": {
+			cr: portOrder(withExternalName(testPortOrderName)),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false, ResourceUpToDate: false},
+			},
+		},
+		"Deleted": {
+			cr: portOrder(withExternalName(testOrderID), func(po *v1alpha1.PortOrder) {
+				now := metav1.Now()
+				po.SetDeletionTimestamp(&now)
+			}),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			var urls []string
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: func(ctx context.Context, method string, url string, body httpclient.Data, headers httpclient.Data, skip bool) (httpclient.HttpDetails, error) {
+					if method == http.MethodGet {
+						urls = append(urls, url)
+					}
+					return respondWith(tc.code, tc.body)(ctx, method, url, body, headers, skip)
+				}},
+				logger: logging.NewNopLogger(),
+			}
+
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.Observe(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("e.Observe(...): -want, +got: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.orderID, tc.cr.Status.AtProvider.OrderID); diff != "" {
+				t.Errorf("e.Observe(...): -want OrderID, +got OrderID: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, tc.cr.Status.AtProvider.Status); diff != "" {
+				t.Errorf("e.Observe(...): -want Status, +got Status: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.urls, urls); diff != "" {
+				t.Errorf("e.Observe(...): -want URLs, +got URLs: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const errRegisterMetrics = "cannot register PortOrder latency metrics"
+
+// maxLatencySamples bounds the response times kept per endpoint. The oldest
+// samples are dropped first.
+const maxLatencySamples = 1000
+
+// latencyQuantiles are the percentiles of response times that are exported.
+var latencyQuantiles = []float64{0.5, 0.95, 0.99}
+
+var latencyDesc = prometheus.NewDesc(
+	"provider_http_portorder_request_latency_seconds",
+	"Percentiles of the orders API response times observed within the latency window, per endpoint.",
+	[]string{"endpoint", "quantile"}, nil,
+)
+
+type latencySample struct {
+	at       time.Time
+	duration time.Duration
+}
+
+// latencyTracker keeps a rolling window of the response times of the orders
+// API per endpoint, and exports their percentiles as gauges. Samples older
+// than the window are dropped, so that the percentiles follow recent
+// behaviour. A nil latencyTracker tracks nothing.
+type latencyTracker struct {
+	window time.Duration
+	now    func() time.Time
+
+	mu      sync.Mutex
+	samples map[string][]latencySample
+}
+
+// newLatencyTracker returns a tracker keeping response times for the
+// supplied window, or nil if it is not positive.
+func newLatencyTracker(window time.Duration) *latencyTracker {
+	if window <= 0 {
+		return nil
+	}
+	return &latencyTracker{window: window, now: time.Now, samples: map[string][]latencySample{}}
+}
+
+// observe records the response time of a request sent to rawURL. Requests
+// are tracked per scheme and host, so that the order IDs in their paths do
+// not create an endpoint each.
+func (t *latencyTracker) observe(rawURL string, d time.Duration) {
+	if t == nil {
+		return
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return
+	}
+	endpoint := u.Scheme + "://" + u.Host
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s := append(t.prune(endpoint, t.now()), latencySample{at: t.now(), duration: d})
+	if len(s) > maxLatencySamples {
+		s = s[len(s)-maxLatencySamples:]
+	}
+	t.samples[endpoint] = s
+}
+
+// prune drops the samples of the endpoint that fell out of the window,
+// forgetting the endpoint if none are left. t.mu must be held.
+func (t *latencyTracker) prune(endpoint string, now time.Time) []latencySample {
+	s := t.samples[endpoint]
+	i := sort.Search(len(s), func(i int) bool { return now.Sub(s[i].at) <= t.window })
+	s = s[i:]
+	if len(s) == 0 {
+		delete(t.samples, endpoint)
+		return nil
+	}
+	t.samples[endpoint] = s
+	return s
+}
+
+// Describe implements prometheus.Collector.
+func (t *latencyTracker) Describe(ch chan<- *prometheus.Desc) {
+	ch <- latencyDesc
+}
+
+// Collect implements prometheus.Collector, exporting the percentiles of the
+// response times within the window of every endpoint.
+func (t *latencyTracker) Collect(ch chan<- prometheus.Metric) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	for endpoint := range t.samples {
+		s := t.prune(endpoint, now)
+		if len(s) == 0 {
+			continue
+		}
+		durations := make([]time.Duration, len(s))
+		for i := range s {
+			durations[i] = s[i].duration
+		}
+		sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+		for _, q := range latencyQuantiles {
+			ch <- prometheus.MustNewConstMetric(latencyDesc, prometheus.GaugeValue,
+				percentile(durations, q).Seconds(), endpoint, strconv.FormatFloat(q, 'f', -1, 64))
+		}
+	}
+}
+
+// percentile returns the nearest-rank percentile q of the sorted durations.
+func percentile(sorted []time.Duration, q float64) time.Duration {
+	i := int(math.Ceil(q*float64(len(sorted)))) - 1
+	if i < 0 {
+		i = 0
+	}
+	return sorted[i]
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func Test_latencyTracker(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	type sample struct {
+		url string
+		ago time.Duration
+		d   time.Duration
+	}
+
+	cases := map[string]struct {
+		samples []sample
+		want    string
+	}{
+		"NoSamples": {},
+		"PerEndpoint": {
+			samples: []sample{
+				{url: "https://api.example.com/orders/order-1", d: 100 * time.Millisecond},
+				{url: "https://api.example.com/orders/order-2", d: 300 * time.Millisecond},
+				{url: "https://api.example.com/orders", d: 200 * time.Millisecond},
+				{url: "https://other.example.com/orders", d: time.Second},
+			},
+			want: `
+# HELP provider_http_portorder_request_latency_seconds Percentiles of the orders API response times observed within the latency window, per endpoint.
+# TYPE provider_http_portorder_request_latency_seconds gauge
+provider_http_portorder_request_latency_seconds{endpoint="https://api.example.com",quantile="0.5"} 0.2
+provider_http_portorder_request_latency_seconds{endpoint="https://api.example.com",quantile="0.95"} 0.3
+provider_http_portorder_request_latency_seconds{endpoint="https://api.example.com",quantile="0.99"} 0.3
+provider_http_portorder_request_latency_seconds{endpoint="https://other.example.com",quantile="0.5"} 1
+provider_http_portorder_request_latency_seconds{endpoint="https://other.example.com",quantile="0.95"} 1
+provider_http_portorder_request_latency_seconds{endpoint="https://other.example.com",quantile="0.99"} 1
+`,
+		},
+		"OutsideWindow": {
+			samples: []sample{
+				{url: "https://api.example.com/orders", ago: 10 * time.Minute, d: 5 * time.Second},
+				{url: "https://api.example.com/orders", ago: time.Minute, d: 100 * time.Millisecond},
+				{url: "https://other.example.com/orders", ago: 10 * time.Minute, d: time.Second},
+			},
+			want: `
+# HELP provider_http_portorder_request_latency_seconds Percentiles of the orders API response times observed within the latency window, per endpoint.
+# TYPE provider_http_portorder_request_latency_seconds gauge
+provider_http_portorder_request_latency_seconds{endpoint="https://api.example.com",quantile="0.5"} 0.1
+provider_http_portorder_request_latency_seconds{endpoint="https://api.example.com",quantile="0.95"} 0.1
+provider_http_portorder_request_latency_seconds{endpoint="https://api.example.com",quantile="0.99"} 0.1
+`,
+		},
+		"InvalidURL": {
+			samples: []sample{{url: "://", d: time.Second}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			l := newLatencyTracker(5 * time.Minute)
+			for _, s := range tc.samples {
+				at := now.Add(-s.ago)
+				l.now = func() time.Time { return at }
+				l.observe(s.url, s.d)
+			}
+			l.now = func() time.Time { return now }
+
+			if err := testutil.CollectAndCompare(l, strings.NewReader(tc.want)); err != nil {
+				t.Fatalf("testutil.CollectAndCompare(...): %v", err)
+			}
+		})
+	}
+}
+
+func Test_percentile(t *testing.T) {
+	sorted := make([]time.Duration, 100)
+	for i := range sorted {
+		sorted[i] = time.Duration(i+1) * time.Millisecond
+	}
+
+	cases := map[string]struct {
+		sorted []time.Duration
+		q      float64
+		want   time.Duration
+	}{
+		"Median":      {sorted: sorted, q: 0.5, want: 50 * time.Millisecond},
+		"P95":         {sorted: sorted, q: 0.95, want: 95 * time.Millisecond},
+		"P99":         {sorted: sorted, q: 0.99, want: 99 * time.Millisecond},
+		"SingleValue": {sorted: []time.Duration{time.Second}, q: 0.99, want: time.Second},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			if got := percentile(tc.sorted, tc.q); got != tc.want {
+				t.Fatalf("percentile(...): want %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
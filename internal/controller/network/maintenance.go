@@ -0,0 +1,67 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	"github.com/crossplane-contrib/provider-http/internal/utils"
+)
+
+const (
+	errMaintenanceCheck      = "cannot check backend maintenance status"
+	errMaintenanceStatusCode = "maintenance endpoint returned status code %d"
+)
+
+// defaultMaintenanceActiveField matches the maintenance status response while
+// maintenance is active, unless the order configures its own field.
+var defaultMaintenanceActiveField = v1alpha1.ResponseFieldMatch{Path: "active", Value: "true"}
+
+// maintenanceActive reports whether the backend is in maintenance according
+// to the supplied maintenance check.
+func (e *external) maintenanceActive(ctx context.Context, m v1alpha1.MaintenanceCheck) (bool, error) {
+	if err := validateEndpoint(m.Endpoint); err != nil {
+		return false, err
+	}
+
+	details, err := e.send(ctx, http.MethodGet, m.Endpoint, "", nil)
+	if err != nil {
+		return false, errors.Wrap(err, errMaintenanceCheck)
+	}
+
+	if details.HttpResponse.StatusCode == http.StatusServiceUnavailable {
+		return true, nil
+	}
+	if !utils.IsHTTPSuccess(details.HttpResponse.StatusCode) {
+		return false, errors.Errorf(errMaintenanceStatusCode, details.HttpResponse.StatusCode)
+	}
+
+	decoded := map[string]interface{}{}
+	if err := decodeJSON(details.HttpResponse.Body, &decoded); err != nil {
+		return false, errors.Wrap(err, errMaintenanceCheck)
+	}
+
+	field := defaultMaintenanceActiveField
+	if m.ActiveField != nil {
+		field = *m.ActiveField
+	}
+	return matchesField(decoded, field), nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+const testMaintenanceEndpoint = "https://api.example.com/maintenance"
+
+func Test_external_maintenanceActive(t *testing.T) {
+	type want struct {
+		active bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		http  httpclient.Client
+		field *v1alpha1.ResponseFieldMatch
+		want  want
+	}{
+		"Active": {
+			http: &MockHttpClient{MockSendRequest: respondWith(200, `{"active":true}`)},
+			want: want{active: true},
+		},
+		"Inactive": {
+			http: &MockHttpClient{MockSendRequest: respondWith(200, `{"active":false}`)},
+		},
+		"ServiceUnavailable": {
+			http: &MockHttpClient{MockSendRequest: respondWith(503, "")},
+			want: want{active: true},
+		},
+		"CustomField": {
+			http:  &MockHttpClient{MockSendRequest: respondWith(200, `{"window":{"state":"open"}}`)},
+			field: &v1alpha1.ResponseFieldMatch{Path: "window.state", Value: "open"},
+			want:  want{active: true},
+		},
+		"UnexpectedStatusCode": {
+			http: &MockHttpClient{MockSendRequest: respondWith(500, "")},
+			want: want{err: errors.Errorf(errMaintenanceStatusCode, 500)},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.http, logger: logging.NewNopLogger()}
+			got, err := e.maintenanceActive(context.Background(), v1alpha1.MaintenanceCheck{
+				Endpoint:    testMaintenanceEndpoint,
+				ActiveField: tc.field,
+			})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.maintenanceActive(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.active, got); diff != "" {
+				t.Fatalf("e.maintenanceActive(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+// portSpan is a port, or an inclusive range of ports, of a protocol in the
+// form of portString.
+type portSpan struct {
+	protocol string
+	from, to int
+	// numbered is false for protocols without port numbers, such as ICMP.
+	numbered bool
+}
+
+// parsePortSpan parses a port in the form of portString, or a range of ports
+// in the form protocol/from-to, e.g. "tcp/8000-8010".
+func parsePortSpan(s string) portSpan {
+	protocol, ports, ok := strings.Cut(s, "/")
+	if !ok {
+		return portSpan{protocol: s}
+	}
+	first, last, isRange := strings.Cut(ports, "-")
+	if !isRange {
+		last = first
+	}
+	from, err := strconv.Atoi(first)
+	if err != nil {
+		return portSpan{protocol: s}
+	}
+	to, err := strconv.Atoi(last)
+	if err != nil || to < from {
+		return portSpan{protocol: s}
+	}
+	return portSpan{protocol: protocol, from: from, to: to, numbered: true}
+}
+
+func (s portSpan) String() string {
+	switch {
+	case !s.numbered:
+		return s.protocol
+	case s.from == s.to:
+		return fmt.Sprintf("%s/%d", s.protocol, s.from)
+	default:
+		return fmt.Sprintf("%s/%d-%d", s.protocol, s.from, s.to)
+	}
+}
+
+// covers reports whether s includes all of p.
+func (s portSpan) covers(p portSpan) bool {
+	if s.protocol != p.protocol || s.numbered != p.numbered {
+		return false
+	}
+	return !s.numbered || (s.from <= p.from && p.to <= s.to)
+}
+
+// without returns the parts of s that are not included in any of the
+// supplied spans.
+func (s portSpan) without(spans []portSpan) []portSpan {
+	var overlapping []portSpan
+	for _, o := range spans {
+		if o.protocol != s.protocol || o.numbered != s.numbered {
+			continue
+		}
+		if o.to >= s.from && o.from <= s.to {
+			overlapping = append(overlapping, o)
+		}
+	}
+	if !s.numbered {
+		if len(overlapping) > 0 {
+			return nil
+		}
+		return []portSpan{s}
+	}
+	slices.SortFunc(overlapping, func(a, b portSpan) int { return a.from - b.from })
+
+	var rest []portSpan
+	next := s.from
+	for _, o := range overlapping {
+		if o.from > next {
+			rest = append(rest, portSpan{protocol: s.protocol, from: next, to: o.from - 1, numbered: true})
+		}
+		next = max(next, o.to+1)
+	}
+	if next <= s.to {
+		rest = append(rest, portSpan{protocol: s.protocol, from: next, to: s.to, numbered: true})
+	}
+	return rest
+}
+
+func parsePortSpans(ports []string) []portSpan {
+	spans := make([]portSpan, 0, len(ports))
+	for _, p := range ports {
+		spans = append(spans, parsePortSpan(p))
+	}
+	return spans
+}
+
+// portMismatch returns the desired ports the backend did not open, and the
+// ports it opened that are not desired, in the form of portString. Protocols
+// are compared by name, and an observed range opens every port in it.
+func portMismatch(observed, desired []string) (missing, extra []string) {
+	o, d := parsePortSpans(observed), parsePortSpans(desired)
+	for _, want := range d {
+		if !slices.ContainsFunc(o, func(got portSpan) bool { return got.covers(want) }) {
+			missing = append(missing, want.String())
+		}
+	}
+	for _, got := range o {
+		for _, rest := range got.without(d) {
+			extra = append(extra, rest.String())
+		}
+	}
+	return missing, extra
+}
+
+// recordPortMismatch sets whether the backend opened other ports for the
+// order than desired. Ports are not compared unless the backend reports them.
+func recordPortMismatch(cr *v1alpha1.PortOrder) {
+	observed := cr.Status.AtProvider.ObservedPorts
+	if len(observed) == 0 {
+		return
+	}
+	missing, extra := portMismatch(observed, desiredPorts(cr.Spec.ForProvider))
+	if len(missing) == 0 && len(extra) == 0 {
+		cr.SetConditions(v1alpha1.PortsMatch())
+		return
+	}
+	cr.SetConditions(v1alpha1.PortMismatch(missing, extra))
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+func Test_portMismatch(t *testing.T) {
+	type want struct {
+		missing []string
+		extra   []string
+	}
+
+	cases := map[string]struct {
+		observed []string
+		desired  []string
+		want     want
+	}{
+		"Match": {
+			observed: []string{"icmp", "tcp/443"},
+			desired:  []string{"icmp", "tcp/443"},
+		},
+		"Missing": {
+			observed: []string{"tcp/443"},
+			desired:  []string{"tcp/22", "tcp/443"},
+			want:     want{missing: []string{"tcp/22"}},
+		},
+		"Extra": {
+			observed: []string{"icmp", "tcp/443"},
+			desired:  []string{"tcp/443"},
+			want:     want{extra: []string{"icmp"}},
+		},
+		"OtherProtocol": {
+			observed: []string{"udp/53"},
+			desired:  []string{"tcp/53"},
+			want:     want{missing: []string{"tcp/53"}, extra: []string{"udp/53"}},
+		},
+		"RangeCoversDesired": {
+			observed: []string{"tcp/8000-8002"},
+			desired:  []string{"tcp/8000", "tcp/8001", "tcp/8002"},
+		},
+		"RangeWiderThanDesired": {
+			observed: []string{"tcp/8000-8010"},
+			desired:  []string{"tcp/8000", "tcp/8005"},
+			want:     want{extra: []string{"tcp/8001-8004", "tcp/8006-8010"}},
+		},
+		"RangeMissingDesired": {
+			observed: []string{"tcp/8000-8002"},
+			desired:  []string{"tcp/8000", "tcp/8003"},
+			want:     want{missing: []string{"tcp/8003"}, extra: []string{"tcp/8001-8002"}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			missing, extra := portMismatch(tc.observed, tc.desired)
+			if diff := cmp.Diff(tc.want.missing, missing); diff != "" {
+				t.Errorf("portMismatch(...): -want missing, +got missing: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.extra, extra); diff != "" {
+				t.Errorf("portMismatch(...): -want extra, +got extra: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Observe_PortMismatch(t *testing.T) {
+	cases := map[string]struct {
+		ports string
+		cr    *v1alpha1.PortOrder
+		want  xpv1.Condition
+	}{
+		"NotReported": {
+			cr:   portOrder(withObservedEndpoints("", "")),
+			want: xpv1.Condition{Type: v1alpha1.TypePortMismatch, Status: "Unknown"},
+		},
+		"Match": {
+			ports: `[{"protocol":"TCP","port":443}]`,
+			cr:    portOrder(withObservedEndpoints("", "")),
+			want:  v1alpha1.PortsMatch(),
+		},
+		"RangeMatch": {
+			ports: `[{"protocol":6,"port":443,"endPort":444}]`,
+			cr: portOrder(withObservedEndpoints("", ""), withPorts(
+				v1alpha1.PortParameters{Type: v1alpha1.PortTypeTCP, Number: 443},
+				v1alpha1.PortParameters{Type: v1alpha1.PortTypeTCP, Number: 444},
+			)),
+			want: v1alpha1.PortsMatch(),
+		},
+		"Dropped": {
+			ports: `[{"protocol":"TCP","port":443}]`,
+			cr: portOrder(withObservedEndpoints("", ""), withPorts(
+				v1alpha1.PortParameters{Type: v1alpha1.PortTypeTCP, Number: 443},
+				v1alpha1.PortParameters{Type: v1alpha1.PortTypeUDP, Number: 53},
+			)),
+			want: v1alpha1.PortMismatch([]string{"udp/53"}, nil),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			body := `{"orderId":"order-123","status":"active"}`
+			if tc.ports != "" {
+				body = `{"orderId":"order-123","status":"active","ports":` + tc.ports + `}`
+			}
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: respondWith(200, body)},
+				logger: logging.NewNopLogger(),
+			}
+
+			if _, err := e.Observe(context.Background(), tc.cr); err != nil {
+				t.Fatalf("e.Observe(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, tc.cr.GetCondition(v1alpha1.TypePortMismatch), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("e.Observe(...): -want PortMismatch condition, +got PortMismatch condition: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/url"
+	"text/template"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+const (
+	errGetNotificationWebhook   = "cannot get PortOrder notification webhook secret %s/%s"
+	errNotificationWebhookNoKey = "PortOrder notification webhook secret %s/%s has no key %q"
+	errNotificationTemplate     = "cannot render PortOrder notification template"
+	errNotificationWebhook      = "PortOrder notification webhook URL must be an http or https URL"
+
+	defaultSuccessTemplate = `PortOrder {{ .Name }} is {{ .Status.AtProvider.Status }}: ` +
+		`{{ .Spec.ForProvider.Source }} to {{ .Spec.ForProvider.Destination }}.`
+	defaultFailureTemplate = `PortOrder {{ .Name }} {{ .Status.AtProvider.Status }}` +
+		`{{ with .Status.AtProvider.StatusReason }}: {{ . }}{{ end }}.`
+)
+
+// notification is the Slack-style message posted to a notification webhook.
+type notification struct {
+	Text string `json:"text"`
+}
+
+// renderNotification renders the message of an order that reached a ready or
+// failed status.
+func renderNotification(n v1alpha1.Notification, cr *v1alpha1.PortOrder) (string, error) {
+	text := n.SuccessTemplate
+	if text == "" {
+		text = defaultSuccessTemplate
+	}
+	if isFailed(cr) {
+		text = n.FailureTemplate
+		if text == "" {
+			text = defaultFailureTemplate
+		}
+	}
+
+	t, err := template.New("notification").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", errors.Wrap(err, errNotificationTemplate)
+	}
+	var b bytes.Buffer
+	if err := t.Execute(&b, cr); err != nil {
+		return "", errors.Wrap(err, errNotificationTemplate)
+	}
+	return b.String(), nil
+}
+
+// notify posts the notification of an order that reached a ready or failed
+// status, if it has one. Notifications that cannot be sent are logged and
+// dropped.
+func (e *external) notify(ctx context.Context, cr *v1alpha1.PortOrder) {
+	n := cr.Spec.ForProvider.Notification
+	if n == nil || e.events == nil {
+		return
+	}
+
+	text, err := renderNotification(*n, cr)
+	if err != nil {
+		e.logger.Info("Cannot send order notification", "name", cr.GetName(), "error", err)
+		return
+	}
+	webhook, err := secretValue(ctx, e.kube, n.WebhookURLSecretRef, errGetNotificationWebhook, errNotificationWebhookNoKey)
+	if err == nil {
+		err = validateWebhook(webhook)
+	}
+	if err != nil {
+		e.logger.Info("Cannot send order notification", "name", cr.GetName(), "error", err)
+		return
+	}
+	body, err := json.Marshal(notification{Text: text})
+	if err != nil {
+		e.logger.Info("Cannot send order notification", "name", cr.GetName(), "error", err)
+		return
+	}
+
+	e.events.post(webhook, "", body, func(err error) {
+		e.logger.Info("Cannot send order notification", "name", cr.GetName(), "error", withoutURL(err))
+	})
+}
+
+// validateWebhook returns an error if the webhook URL is not an http or https
+// URL. Unlike validateEndpoint, the URL is not part of the error, since it
+// usually embeds credentials.
+func validateWebhook(webhook string) error {
+	u, err := url.Parse(webhook)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New(errNotificationWebhook)
+	}
+	return nil
+}
+
+// withoutURL strips the request URL from errors returned by the HTTP client.
+func withoutURL(err error) error {
+	var uerr *url.Error
+	if errors.As(err, &uerr) {
+		return errors.Errorf("%s: %v", uerr.Op, uerr.Err)
+	}
+	return err
+}
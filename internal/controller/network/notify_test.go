@@ -0,0 +1,205 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+const testWebhook = "https://hooks.example.com/services/T0/B0/s3cr3t"
+
+func Test_renderNotification(t *testing.T) {
+	type want struct {
+		text string
+		err  bool
+	}
+
+	cases := map[string]struct {
+		n      v1alpha1.Notification
+		status string
+		reason string
+		want   want
+	}{
+		"DefaultSuccess": {
+			status: "active",
+			want:   want{text: "PortOrder test-order is active: 10.0.0.0/24 to 10.0.1.0/24."},
+		},
+		"DefaultFailure": {
+			status: "rejected",
+			reason: "port 22 is not allowed",
+			want:   want{text: "PortOrder test-order rejected: port 22 is not allowed."},
+		},
+		"DefaultFailureWithoutReason": {
+			status: "failed",
+			want:   want{text: "PortOrder test-order failed."},
+		},
+		"CustomSuccess": {
+			n: v1alpha1.Notification{
+				SuccessTemplate: `:white_check_mark: {{ .Spec.ForProvider.Destination }} opened ({{ .Status.AtProvider.OrderID }})`,
+				FailureTemplate: `:x: {{ .Name }}`,
+			},
+			status: "complete",
+			want:   want{text: ":white_check_mark: 10.0.1.0/24 opened (order-123)"},
+		},
+		"CustomFailure": {
+			n: v1alpha1.Notification{
+				SuccessTemplate: `:white_check_mark: {{ .Name }}`,
+				FailureTemplate: `:x: {{ .Name }}: {{ .Status.AtProvider.StatusReason }}`,
+			},
+			status: "error",
+			reason: "backend unavailable",
+			want:   want{text: ":x: test-order: backend unavailable"},
+		},
+		"InvalidTemplate": {
+			n:      v1alpha1.Notification{SuccessTemplate: `{{ .Name `},
+			status: "active",
+			want:   want{err: true},
+		},
+		"UnknownField": {
+			n:      v1alpha1.Notification{SuccessTemplate: `{{ .Owner }}`},
+			status: "active",
+			want:   want{err: true},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.OrderID = testOrderID
+				po.Status.AtProvider.Status = tc.status
+				po.Status.AtProvider.StatusReason = tc.reason
+			})
+
+			got, err := renderNotification(tc.n, cr)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Fatalf("renderNotification(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.text, got); diff != "" {
+				t.Fatalf("renderNotification(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_notify(t *testing.T) {
+	ref := xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "slack"},
+		Key:             "url",
+	}
+	type sent struct {
+		url  string
+		body string
+	}
+
+	cases := map[string]struct {
+		n      *v1alpha1.Notification
+		secret *corev1.Secret
+		want   []sent
+	}{
+		"NoNotification": {},
+		"Sent": {
+			n:      &v1alpha1.Notification{WebhookURLSecretRef: ref, SuccessTemplate: `{{ .Name }} is done`},
+			secret: &corev1.Secret{Data: map[string][]byte{"url": []byte(testWebhook)}},
+			want:   []sent{{url: testWebhook, body: `{"text":"test-order is done"}`}},
+		},
+		"SecretMissing": {
+			n: &v1alpha1.Notification{WebhookURLSecretRef: ref},
+		},
+		"InvalidWebhook": {
+			n:      &v1alpha1.Notification{WebhookURLSecretRef: ref},
+			secret: &corev1.Secret{Data: map[string][]byte{"url": []byte("hooks.example.com")}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			var got []sent
+			p := &eventPublisher{
+				client: &MockHttpClient{MockSendRequest: func(_ context.Context, _ string, u string, body httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+					got = append(got, sent{url: u, body: body.Decrypted.(string)})
+					return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 200}}, nil
+				}},
+				logger: logging.NewNopLogger(),
+			}
+			e := &external{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+						if tc.secret == nil {
+							return kerrors.NewNotFound(corev1.Resource("secrets"), key.Name)
+						}
+						tc.secret.DeepCopyInto(obj.(*corev1.Secret))
+						return nil
+					},
+				},
+				logger: logging.NewNopLogger(),
+				events: p,
+			}
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.Notification = tc.n
+				po.Status.AtProvider.Status = "active"
+			})
+
+			e.notify(context.Background(), cr)
+			p.wg.Wait()
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(sent{})); diff != "" {
+				t.Fatalf("e.notify(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_withoutURL(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want error
+	}{
+		"NotAURLError": {
+			err:  errBoom,
+			want: errBoom,
+		},
+		"URLError": {
+			err:  errors.Wrap(&url.Error{Op: "Post", URL: testWebhook, Err: errBoom}, errEventSend),
+			want: errors.New("Post: boom"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, withoutURL(tc.err), test.EquateErrors()); diff != "" {
+				t.Fatalf("withoutURL(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+// paused reports whether the order is frozen by its paused annotation, and
+// records the Paused condition when that changes.
+func paused(cr *v1alpha1.PortOrder) bool {
+	if strings.EqualFold(cr.GetAnnotations()[v1alpha1.AnnotationKeyPaused], "true") {
+		if cr.GetCondition(v1alpha1.TypePaused).Status != corev1.ConditionTrue {
+			cr.SetConditions(v1alpha1.Paused())
+		}
+		return true
+	}
+
+	if cr.GetCondition(v1alpha1.TypePaused).Status == corev1.ConditionTrue {
+		cr.SetConditions(v1alpha1.Resumed())
+	}
+	return false
+}
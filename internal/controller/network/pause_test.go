@@ -0,0 +1,104 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+func withPaused(value string) func(*v1alpha1.PortOrder) {
+	return func(po *v1alpha1.PortOrder) {
+		po.SetAnnotations(map[string]string{v1alpha1.AnnotationKeyPaused: value})
+	}
+}
+
+func Test_paused(t *testing.T) {
+	type want struct {
+		paused    bool
+		condition corev1.ConditionStatus
+	}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.PortOrder
+		want want
+	}{
+		"NotAnnotated": {
+			cr:   portOrder(),
+			want: want{condition: corev1.ConditionUnknown},
+		},
+		"Paused": {
+			cr:   portOrder(withPaused("true")),
+			want: want{paused: true, condition: corev1.ConditionTrue},
+		},
+		"NotTrue": {
+			cr:   portOrder(withPaused("no")),
+			want: want{condition: corev1.ConditionUnknown},
+		},
+		"Resumed": {
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.SetConditions(v1alpha1.Paused())
+			}),
+			want: want{condition: corev1.ConditionFalse},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want.paused, paused(tc.cr)); diff != "" {
+				t.Fatalf("paused(...): -want, +got: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, tc.cr.GetCondition(v1alpha1.TypePaused).Status); diff != "" {
+				t.Fatalf("paused(...): -want condition status, +got condition status: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Paused(t *testing.T) {
+	// The external client has no HTTP client, so any backend call panics.
+	e := &external{logger: logging.NewNopLogger()}
+	cr := portOrder(withPaused("true"), func(po *v1alpha1.PortOrder) {
+		po.Status.AtProvider.OrderID = testOrderID
+	})
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, obs); diff != "" {
+		t.Fatalf("e.Observe(...): -want, +got: %s", diff)
+	}
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("e.Create(...): %v", err)
+	}
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Fatalf("e.Delete(...): %v", err)
+	}
+}
@@ -0,0 +1,364 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+// defaultTenantAnnotation is set by Crossplane on resources composed for a
+// claim.
+const defaultTenantAnnotation = "crossplane.io/claim-namespace"
+
+const defaultPortType = v1alpha1.PortTypeTCP
+
+const (
+	errDirectionInvalid      = "direction %q must be one of ingress, egress or both"
+	errEnvironmentInvalid    = "environment %q must be one of dev, stage or prod"
+	errProtocolFormatInvalid = "protocolFormat %q must be one of name or number"
+	errProtocolNoNumber      = "protocol %q has no IP protocol number"
+	errCanonicalBody         = "cannot canonicalize request body"
+	errGetJustification      = "cannot get PortOrder justification secret %s/%s"
+	errJustificationNoKey    = "PortOrder justification secret %s/%s has no key %q"
+	errBodyFieldReserved     = "annotation %s cannot set the %q body field"
+	errBodyFieldValue        = "annotation %s has malformed quoted value %s"
+)
+
+// reasonCIDRNormalized is the reason of the event recorded when the source or
+// destination of an order is sent in its network address form.
+const reasonCIDRNormalized event.Reason = "NormalizedCIDR"
+
+// protocolNumbers are the IP protocol numbers of the port protocols.
+var protocolNumbers = map[string]int{
+	"icmp": 1,
+	"tcp":  6,
+	"udp":  17,
+}
+
+// orderDirection returns the traffic direction of the order, defaulting to
+// ingress.
+func orderDirection(p v1alpha1.PortOrderParameters) (string, error) {
+	switch p.Direction {
+	case "":
+		return v1alpha1.DirectionIngress, nil
+	case v1alpha1.DirectionIngress, v1alpha1.DirectionEgress, v1alpha1.DirectionBoth:
+		return p.Direction, nil
+	default:
+		return "", errors.Errorf(errDirectionInvalid, p.Direction)
+	}
+}
+
+// orderEnvironment returns the environment the order targets, if any.
+func orderEnvironment(p v1alpha1.PortOrderParameters) (string, error) {
+	switch p.Environment {
+	case "", v1alpha1.EnvironmentDev, v1alpha1.EnvironmentStage, v1alpha1.EnvironmentProd:
+		return p.Environment, nil
+	default:
+		return "", errors.Errorf(errEnvironmentInvalid, p.Environment)
+	}
+}
+
+// marshalOrder encodes the create request, without its empty fields when the
+// order prunes them, and indented when the order asks for a pretty-printed
+// body.
+func marshalOrder(p v1alpha1.PortOrderParameters, req OrderRequest) ([]byte, error) {
+	var v interface{} = req
+	if len(req.Fields) > 0 {
+		body := map[string]interface{}{"order": req.Order}
+		for k, f := range req.Fields {
+			body[k] = f
+		}
+		v = body
+	}
+	if p.PruneEmptyFields {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		var decoded interface{}
+		if err := decodeJSON(string(b), &decoded); err != nil {
+			return nil, err
+		}
+		v, _ = pruneEmpty(decoded)
+	}
+	if p.PrettyPrintBody {
+		return json.MarshalIndent(v, "", "  ")
+	}
+	return json.Marshal(v)
+}
+
+// pruneEmpty removes the null values, empty strings and empty objects and
+// lists from a decoded JSON value, including those that are empty once
+// pruned themselves. It reports whether the value is empty. Numbers and
+// booleans are never empty.
+func pruneEmpty(v interface{}) (interface{}, bool) {
+	switch t := v.(type) {
+	case nil:
+		return nil, true
+	case string:
+		return t, t == ""
+	case map[string]interface{}:
+		for k, f := range t {
+			pruned, empty := pruneEmpty(f)
+			if empty {
+				delete(t, k)
+				continue
+			}
+			t[k] = pruned
+		}
+		return t, len(t) == 0
+	case []interface{}:
+		kept := t[:0]
+		for _, e := range t {
+			if pruned, empty := pruneEmpty(e); !empty {
+				kept = append(kept, pruned)
+			}
+		}
+		return kept, len(kept) == 0
+	default:
+		return t, false
+	}
+}
+
+// canonicalJSON re-encodes a JSON body compactly with the keys of every
+// object sorted, so that the same payload always produces the same bytes.
+// Numbers and strings are kept exactly as they are written. An empty body is
+// returned unchanged.
+func canonicalJSON(body string) (string, error) {
+	if strings.TrimSpace(body) == "" {
+		return body, nil
+	}
+
+	d := json.NewDecoder(strings.NewReader(body))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return "", errors.Wrap(err, errCanonicalBody)
+	}
+
+	// Maps are encoded with sorted keys.
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return "", errors.Wrap(err, errCanonicalBody)
+	}
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+// normalizeCIDR returns the network address form of a CIDR, with its host
+// bits zeroed. Values that are not CIDRs are returned unchanged.
+func normalizeCIDR(s string) string {
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		return s
+	}
+	return n.String()
+}
+
+// desiredEndpoints returns the source and destination the order is sent
+// with, normalized unless the order skips normalization.
+func desiredEndpoints(p v1alpha1.PortOrderParameters) (string, string) {
+	if p.SkipCIDRNormalization {
+		return p.Source, p.Destination
+	}
+	return normalizeCIDR(p.Source), normalizeCIDR(p.Destination)
+}
+
+// orderEndpoints returns the desired source and destination of the order,
+// recording a warning event if either was normalized.
+func (e *external) orderEndpoints(cr *v1alpha1.PortOrder) (string, string) {
+	source, destination := desiredEndpoints(cr.Spec.ForProvider)
+	if e.recorder == nil {
+		return source, destination
+	}
+	if source != cr.Spec.ForProvider.Source {
+		e.recorder.Event(cr, event.Warning(reasonCIDRNormalized, errors.Errorf("source %s was sent as %s", cr.Spec.ForProvider.Source, source)))
+	}
+	if destination != cr.Spec.ForProvider.Destination {
+		e.recorder.Event(cr, event.Warning(reasonCIDRNormalized, errors.Errorf("destination %s was sent as %s", cr.Spec.ForProvider.Destination, destination)))
+	}
+	return source, destination
+}
+
+// orderTags returns the labels of the PortOrder that carry the configured tag
+// prefix, keyed by their name without the prefix.
+func orderTags(cr *v1alpha1.PortOrder) map[string]string {
+	prefix := cr.Spec.ForProvider.TagLabelPrefix
+	if prefix == "" {
+		return nil
+	}
+
+	var tags map[string]string
+	for k, v := range cr.GetLabels() {
+		name := strings.TrimPrefix(k, prefix)
+		if name == k || name == "" {
+			continue
+		}
+		if tags == nil {
+			tags = map[string]string{}
+		}
+		tags[name] = v
+	}
+	return tags
+}
+
+// bodyFields returns the annotations of the PortOrder that carry the
+// configured body field prefix, keyed by their name without the prefix and
+// with their values coerced by bodyFieldValue.
+func bodyFields(cr *v1alpha1.PortOrder) (map[string]interface{}, error) {
+	prefix := cr.Spec.ForProvider.BodyFieldAnnotationPrefix
+	if prefix == "" {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(cr.GetAnnotations()))
+	for k := range cr.GetAnnotations() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var fields map[string]interface{}
+	for _, k := range keys {
+		name := strings.TrimPrefix(k, prefix)
+		if name == k || name == "" {
+			continue
+		}
+		if name == "order" {
+			return nil, errors.Errorf(errBodyFieldReserved, k, name)
+		}
+		v, err := bodyFieldValue(cr.GetAnnotations()[k])
+		if err != nil {
+			return nil, errors.Wrapf(err, errBodyFieldValue, k, cr.GetAnnotations()[k])
+		}
+		if fields == nil {
+			fields = map[string]interface{}{}
+		}
+		fields[name] = v
+	}
+	return fields, nil
+}
+
+// bodyFieldValue coerces an annotation value to the JSON type it is sent as:
+// true and false are booleans, JSON numbers are sent exactly as written, a
+// double-quoted value is the string it quotes and anything else is a string.
+func bodyFieldValue(v string) (interface{}, error) {
+	switch {
+	case v == "true", v == "false":
+		return v == "true", nil
+	case strings.HasPrefix(v, `"`):
+		return strconv.Unquote(v)
+	case v != "" && (v[0] == '-' || (v[0] >= '0' && v[0] <= '9')) && json.Valid([]byte(v)):
+		return json.Number(v), nil
+	default:
+		return v, nil
+	}
+}
+
+// orderTenant returns the tenant of the order, read from the configured
+// annotation, or from a label of the same name. It is empty when neither is
+// present.
+func orderTenant(cr *v1alpha1.PortOrder) string {
+	key := cr.Spec.ForProvider.TenantAnnotation
+	if key == "" {
+		key = defaultTenantAnnotation
+	}
+
+	if v, ok := cr.GetAnnotations()[key]; ok {
+		return v
+	}
+	return cr.GetLabels()[key]
+}
+
+// convertPorts converts the ports of the order from our CRD format to the API
+// format, sending protocols in the configured format. If the order sorts its
+// ports, they are sorted by protocol name, number, label and comment, so that
+// equivalent orders convert to the same ports in the same order.
+func (e *external) convertPorts(params v1alpha1.PortOrderParameters) ([]PortEntry, error) {
+	ports := params.Ports
+	if params.SortPorts {
+		ports = append([]v1alpha1.PortParameters{}, ports...)
+		sort.SliceStable(ports, func(i, j int) bool {
+			a, b := ports[i], ports[j]
+			switch {
+			case portType(a) != portType(b):
+				return portType(a) < portType(b)
+			case a.Number != b.Number:
+				return a.Number < b.Number
+			case a.Label != b.Label:
+				return a.Label < b.Label
+			default:
+				return a.Comment < b.Comment
+			}
+		})
+	}
+
+	result := make([]PortEntry, len(ports))
+	for i, p := range ports {
+		protocol, err := portProtocol(params.ProtocolFormat, portType(p))
+		if err != nil {
+			return nil, err
+		}
+		result[i] = PortEntry{
+			Protocol: protocol,
+			Label:    p.Label,
+			Comment:  p.Comment,
+		}
+		if portType(p) != v1alpha1.PortTypeICMP {
+			result[i].Port = ptr.To(p.Number)
+		}
+	}
+	return result, nil
+}
+
+// portType returns the protocol type of the port, defaulting to tcp for
+// ports that were not defaulted by the API server.
+func portType(p v1alpha1.PortParameters) string {
+	if p.Type == "" {
+		return defaultPortType
+	}
+	return p.Type
+}
+
+// portProtocol returns the protocol in the supplied format, defaulting to
+// its upper case name.
+func portProtocol(format, protocol string) (interface{}, error) {
+	switch format {
+	case "", v1alpha1.ProtocolFormatName:
+		return strings.ToUpper(protocol), nil
+	case v1alpha1.ProtocolFormatNumber:
+		n, ok := protocolNumbers[strings.ToLower(protocol)]
+		if !ok {
+			return nil, errors.Errorf(errProtocolNoNumber, protocol)
+		}
+		return n, nil
+	default:
+		return nil, errors.Errorf(errProtocolFormatInvalid, format)
+	}
+}
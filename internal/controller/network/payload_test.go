@@ -0,0 +1,818 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+// eventRecorder records the messages of the events it receives.
+type eventRecorder struct {
+	messages []string
+}
+
+func (r *eventRecorder) Event(_ runtime.Object, e event.Event) {
+	r.messages = append(r.messages, e.Message)
+}
+
+func (r *eventRecorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
+
+func Test_orderEndpoints(t *testing.T) {
+	type want struct {
+		source      string
+		destination string
+		events      []string
+	}
+
+	cases := map[string]struct {
+		params v1alpha1.PortOrderParameters
+		want   want
+	}{
+		"Canonical": {
+			params: v1alpha1.PortOrderParameters{Source: "10.0.0.0/24", Destination: "192.168.1.7"},
+			want:   want{source: "10.0.0.0/24", destination: "192.168.1.7"},
+		},
+		"HostBitsSet": {
+			params: v1alpha1.PortOrderParameters{Source: "10.0.0.5/24", Destination: "172.16.9.1/16"},
+			want: want{
+				source:      "10.0.0.0/24",
+				destination: "172.16.0.0/16",
+				events: []string{
+					"source 10.0.0.5/24 was sent as 10.0.0.0/24",
+					"destination 172.16.9.1/16 was sent as 172.16.0.0/16",
+				},
+			},
+		},
+		"NormalizationSkipped": {
+			params: v1alpha1.PortOrderParameters{Source: "10.0.0.5/24", Destination: "10.0.1.0/24", SkipCIDRNormalization: true},
+			want:   want{source: "10.0.0.5/24", destination: "10.0.1.0/24"},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			r := &eventRecorder{}
+			e := &external{recorder: r}
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider = tc.params
+			})
+
+			got := want{}
+			got.source, got.destination = e.orderEndpoints(cr)
+			got.events = r.messages
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Fatalf("e.orderEndpoints(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_orderTags(t *testing.T) {
+	labels := map[string]string{
+		"order.example.com/cost-center": "cc-42",
+		"order.example.com/team":        "netsec",
+		"app.kubernetes.io/name":        "firewall",
+	}
+
+	cases := map[string]struct {
+		prefix string
+		labels map[string]string
+		want   map[string]string
+	}{
+		"NoPrefix": {
+			labels: labels,
+		},
+		"NoMatchingLabels": {
+			prefix: "other.example.com/",
+			labels: labels,
+		},
+		"MatchingLabels": {
+			prefix: "order.example.com/",
+			labels: labels,
+			want: map[string]string{
+				"cost-center": "cc-42",
+				"team":        "netsec",
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.SetLabels(tc.labels)
+				po.Spec.ForProvider.TagLabelPrefix = tc.prefix
+			})
+			if diff := cmp.Diff(tc.want, orderTags(cr)); diff != "" {
+				t.Fatalf("orderTags(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_orderTenant(t *testing.T) {
+	cases := map[string]struct {
+		annotation  string
+		annotations map[string]string
+		labels      map[string]string
+		want        string
+	}{
+		"Absent": {},
+		"DefaultAnnotation": {
+			annotations: map[string]string{defaultTenantAnnotation: "team-a"},
+			want:        "team-a",
+		},
+		"DefaultLabel": {
+			labels: map[string]string{defaultTenantAnnotation: "team-b"},
+			want:   "team-b",
+		},
+		"CustomAnnotation": {
+			annotation:  "example.com/tenant",
+			annotations: map[string]string{"example.com/tenant": "team-c", defaultTenantAnnotation: "team-a"},
+			want:        "team-c",
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.SetAnnotations(tc.annotations)
+				po.SetLabels(tc.labels)
+				po.Spec.ForProvider.TenantAnnotation = tc.annotation
+			})
+			if diff := cmp.Diff(tc.want, orderTenant(cr)); diff != "" {
+				t.Fatalf("orderTenant(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_orderDirection(t *testing.T) {
+	type want struct {
+		direction string
+		err       error
+	}
+
+	cases := map[string]struct {
+		direction string
+		want      want
+	}{
+		"DefaultsToIngress": {
+			want: want{direction: v1alpha1.DirectionIngress},
+		},
+		"Egress": {
+			direction: v1alpha1.DirectionEgress,
+			want:      want{direction: v1alpha1.DirectionEgress},
+		},
+		"Both": {
+			direction: v1alpha1.DirectionBoth,
+			want:      want{direction: v1alpha1.DirectionBoth},
+		},
+		"Invalid": {
+			direction: "sideways",
+			want:      want{err: errors.Errorf(errDirectionInvalid, "sideways")},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got, err := orderDirection(v1alpha1.PortOrderParameters{Direction: tc.direction})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("orderDirection(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.direction, got); diff != "" {
+				t.Fatalf("orderDirection(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_orderEnvironment(t *testing.T) {
+	type want struct {
+		environment string
+		err         error
+	}
+
+	cases := map[string]struct {
+		environment string
+		want        want
+	}{
+		"Unset": {},
+		"Dev": {
+			environment: v1alpha1.EnvironmentDev,
+			want:        want{environment: v1alpha1.EnvironmentDev},
+		},
+		"Prod": {
+			environment: v1alpha1.EnvironmentProd,
+			want:        want{environment: v1alpha1.EnvironmentProd},
+		},
+		"Invalid": {
+			environment: "production",
+			want:        want{err: errors.Errorf(errEnvironmentInvalid, "production")},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got, err := orderEnvironment(v1alpha1.PortOrderParameters{Environment: tc.environment})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("orderEnvironment(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.environment, got); diff != "" {
+				t.Fatalf("orderEnvironment(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_bodyFields(t *testing.T) {
+	type want struct {
+		fields map[string]interface{}
+		err    error
+	}
+
+	cases := map[string]struct {
+		prefix      string
+		annotations map[string]string
+		want        want
+	}{
+		"NoPrefix": {
+			annotations: map[string]string{"body.order.example.com/foo": "bar"},
+		},
+		"NoMatchingAnnotations": {
+			prefix:      "body.order.example.com/",
+			annotations: map[string]string{"crossplane.io/external-name": "order-123"},
+		},
+		"Coerced": {
+			prefix: "body.order.example.com/",
+			annotations: map[string]string{
+				"body.order.example.com/foo":      "bar",
+				"body.order.example.com/urgent":   "true",
+				"body.order.example.com/priority": "3",
+				"body.order.example.com/weight":   "-0.5",
+				"body.order.example.com/ticket":   `"1234"`,
+				"body.order.example.com/version":  "1.2.3",
+				"body.order.example.com/empty":    "",
+				"crossplane.io/external-name":     "order-123",
+			},
+			want: want{fields: map[string]interface{}{
+				"foo":      "bar",
+				"urgent":   true,
+				"priority": json.Number("3"),
+				"weight":   json.Number("-0.5"),
+				"ticket":   "1234",
+				"version":  "1.2.3",
+				"empty":    "",
+			}},
+		},
+		"Reserved": {
+			prefix:      "body.order.example.com/",
+			annotations: map[string]string{"body.order.example.com/order": "x"},
+			want: want{
+				err: errors.Errorf(errBodyFieldReserved, "body.order.example.com/order", "order"),
+			},
+		},
+		"MalformedQuote": {
+			prefix:      "body.order.example.com/",
+			annotations: map[string]string{"body.order.example.com/ticket": `"1234`},
+			want: want{
+				err: errors.Wrapf(strconv.ErrSyntax, errBodyFieldValue, "body.order.example.com/ticket", `"1234`),
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.SetAnnotations(tc.annotations)
+				po.Spec.ForProvider.BodyFieldAnnotationPrefix = tc.prefix
+			})
+			got, err := bodyFields(cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("bodyFields(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.fields, got); diff != "" {
+				t.Fatalf("bodyFields(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_marshalOrder(t *testing.T) {
+	req := OrderRequest{Order: OrderPayload{Source: "10.0.0.1", Direction: v1alpha1.DirectionIngress}}
+
+	cases := map[string]struct {
+		pretty bool
+		want   string
+	}{
+		"Compact": {
+			want: `{"order":{"source":"10.0.0.1","destination":"","ports":null,"direction":"ingress"}}`,
+		},
+		"PrettyPrinted": {
+			pretty: true,
+			want:   "{\n  \"order\": {\n    \"source\": \"10.0.0.1\",\n    \"destination\": \"\",\n    \"ports\": null,\n    \"direction\": \"ingress\"\n  }\n}",
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got, err := marshalOrder(v1alpha1.PortOrderParameters{PrettyPrintBody: tc.pretty}, req)
+			if err != nil {
+				t.Fatalf("marshalOrder(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, string(got)); diff != "" {
+				t.Fatalf("marshalOrder(...): -want, +got: %s", diff)
+			}
+
+			// The backend parses either form into the same request.
+			var decoded OrderRequest
+			if err := json.Unmarshal(got, &decoded); err != nil {
+				t.Fatalf("json.Unmarshal(...): %v", err)
+			}
+			if diff := cmp.Diff(req, decoded); diff != "" {
+				t.Fatalf("json.Unmarshal(marshalOrder(...)): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_marshalOrder_Fields(t *testing.T) {
+	req := OrderRequest{
+		Order:  OrderPayload{Source: "10.0.0.1", Direction: v1alpha1.DirectionIngress},
+		Fields: map[string]interface{}{"urgent": true, "priority": json.Number("3"), "foo": "bar"},
+	}
+
+	got, err := marshalOrder(v1alpha1.PortOrderParameters{}, req)
+	if err != nil {
+		t.Fatalf("marshalOrder(...): %v", err)
+	}
+	want := `{"foo":"bar","order":{"source":"10.0.0.1","destination":"","ports":null,"direction":"ingress"},"priority":3,"urgent":true}`
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Fatalf("marshalOrder(...): -want, +got: %s", diff)
+	}
+}
+
+func Test_marshalOrder_PruneEmptyFields(t *testing.T) {
+	cases := map[string]struct {
+		req  OrderRequest
+		want string
+	}{
+		"EmptyOptionalFields": {
+			req: OrderRequest{Order: OrderPayload{
+				Source:      "10.0.0.1",
+				Destination: "10.0.1.0/24",
+				Ports:       []PortEntry{{Protocol: "TCP", Port: ptr.To(443)}},
+				Direction:   v1alpha1.DirectionIngress,
+				Tags:        map[string]string{},
+			}},
+			want: `{"order":{"destination":"10.0.1.0/24","direction":"ingress","ports":[{"port":443,"protocol":"TCP"}],"source":"10.0.0.1"}}`,
+		},
+		"EmptyRequiredFields": {
+			req:  OrderRequest{Order: OrderPayload{Source: "10.0.0.1", Direction: v1alpha1.DirectionIngress}},
+			want: `{"order":{"direction":"ingress","source":"10.0.0.1"}}`,
+		},
+		"ZeroNumbersAndBooleansKept": {
+			req: OrderRequest{Order: OrderPayload{
+				Source:            "10.0.0.1",
+				Ports:             []PortEntry{{Protocol: "UDP", Port: ptr.To(0)}},
+				RolloutPercentage: ptr.To(0),
+			}},
+			want: `{"order":{"ports":[{"port":0,"protocol":"UDP"}],"rolloutPercentage":0,"source":"10.0.0.1"}}`,
+		},
+		"Fields": {
+			req: OrderRequest{
+				Order: OrderPayload{Source: "10.0.0.1"},
+				Fields: map[string]interface{}{
+					"urgent":   false,
+					"priority": json.Number("3"),
+					"foo":      "",
+					"meta":     map[string]interface{}{"owner": nil},
+				},
+			},
+			want: `{"order":{"source":"10.0.0.1"},"priority":3,"urgent":false}`,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got, err := marshalOrder(v1alpha1.PortOrderParameters{PruneEmptyFields: true}, tc.req)
+			if err != nil {
+				t.Fatalf("marshalOrder(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, string(got)); diff != "" {
+				t.Fatalf("marshalOrder(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_convertPorts_SortPorts(t *testing.T) {
+	ports := []v1alpha1.PortParameters{
+		{Type: "udp", Number: 53},
+		{Type: "tcp", Number: 8443, Label: "api"},
+		{Type: "icmp"},
+		{Type: "tcp", Number: 443, Comment: "web"},
+	}
+	e := &external{}
+	want, err := e.convertPorts(v1alpha1.PortOrderParameters{Ports: ports, SortPorts: true})
+	if err != nil {
+		t.Fatalf("convertPorts(...): unexpected error: %s", err)
+	}
+
+	// Every permutation of the ports must convert to the same ports.
+	var permute func(n int)
+	permute = func(n int) {
+		if n == 1 {
+			got, err := e.convertPorts(v1alpha1.PortOrderParameters{Ports: ports, SortPorts: true})
+			if err != nil {
+				t.Fatalf("convertPorts(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Fatalf("convertPorts(%v): -want, +got: %s", ports, diff)
+			}
+			return
+		}
+		for i := 0; i < n; i++ {
+			permute(n - 1)
+			j := 0
+			if n%2 == 0 {
+				j = i
+			}
+			ports[j], ports[n-1] = ports[n-1], ports[j]
+		}
+	}
+	permute(len(ports))
+}
+
+func Test_convertPorts(t *testing.T) {
+	ports := []v1alpha1.PortParameters{
+		{Type: "tcp", Number: 50000, Label: "jenkins-agent", Comment: "inbound agents"},
+		{Type: "udp", Number: 53},
+	}
+
+	type want struct {
+		body string
+		err  error
+	}
+
+	cases := map[string]struct {
+		format string
+		sort   bool
+		ports  []v1alpha1.PortParameters
+		want   want
+	}{
+		"DefaultsToName": {
+			ports: ports,
+			want:  want{body: `[{"protocol":"TCP","port":50000,"label":"jenkins-agent","comment":"inbound agents"},{"protocol":"UDP","port":53}]`},
+		},
+		"Name": {
+			format: v1alpha1.ProtocolFormatName,
+			ports:  ports,
+			want:   want{body: `[{"protocol":"TCP","port":50000,"label":"jenkins-agent","comment":"inbound agents"},{"protocol":"UDP","port":53}]`},
+		},
+		"Number": {
+			format: v1alpha1.ProtocolFormatNumber,
+			ports:  ports,
+			want:   want{body: `[{"protocol":6,"port":50000,"label":"jenkins-agent","comment":"inbound agents"},{"protocol":17,"port":53}]`},
+		},
+		"TypeDefaultsToTCP": {
+			ports: []v1alpha1.PortParameters{{Number: 443}, {Type: "udp", Number: 53}},
+			want:  want{body: `[{"protocol":"TCP","port":443},{"protocol":"UDP","port":53}]`},
+		},
+		"TypeDefaultsToTCPNumber": {
+			format: v1alpha1.ProtocolFormatNumber,
+			ports:  []v1alpha1.PortParameters{{Number: 443}},
+			want:   want{body: `[{"protocol":6,"port":443}]`},
+		},
+		"ICMPHasNoPort": {
+			ports: []v1alpha1.PortParameters{{Type: "icmp", Label: "ping"}, {Number: 443}},
+			want:  want{body: `[{"protocol":"ICMP","label":"ping"},{"protocol":"TCP","port":443}]`},
+		},
+		"ICMPNumber": {
+			format: v1alpha1.ProtocolFormatNumber,
+			ports:  []v1alpha1.PortParameters{{Type: "icmp"}},
+			want:   want{body: `[{"protocol":1}]`},
+		},
+		"AnyPort": {
+			ports: []v1alpha1.PortParameters{{Type: "udp"}},
+			want:  want{body: `[{"protocol":"UDP","port":0}]`},
+		},
+		"NumberUnknown": {
+			format: v1alpha1.ProtocolFormatNumber,
+			ports:  []v1alpha1.PortParameters{{Type: "sctp", Number: 9}},
+			want:   want{err: errors.Errorf(errProtocolNoNumber, "sctp")},
+		},
+		"InvalidFormat": {
+			format: "hex",
+			ports:  ports,
+			want:   want{err: errors.Errorf(errProtocolFormatInvalid, "hex")},
+		},
+		"Sorted": {
+			sort:  true,
+			ports: []v1alpha1.PortParameters{{Type: "udp", Number: 53}, {Number: 8443}, {Type: "icmp"}, {Number: 443}},
+			want:  want{body: `[{"protocol":"ICMP"},{"protocol":"TCP","port":443},{"protocol":"TCP","port":8443},{"protocol":"UDP","port":53}]`},
+		},
+		"SortedByNameWithNumbers": {
+			format: v1alpha1.ProtocolFormatNumber,
+			sort:   true,
+			ports:  []v1alpha1.PortParameters{{Type: "udp", Number: 53}, {Type: "icmp"}, {Number: 443}},
+			want:   want{body: `[{"protocol":1},{"protocol":6,"port":443},{"protocol":17,"port":53}]`},
+		},
+		"SortedByLabel": {
+			sort:  true,
+			ports: []v1alpha1.PortParameters{{Number: 443, Label: "web"}, {Number: 443, Label: "api"}},
+			want:  want{body: `[{"protocol":"TCP","port":443,"label":"api"},{"protocol":"TCP","port":443,"label":"web"}]`},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			e := &external{}
+			got, err := e.convertPorts(v1alpha1.PortOrderParameters{ProtocolFormat: tc.format, Ports: tc.ports, SortPorts: tc.sort})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("convertPorts(...): -want error, +got error: %s", diff)
+			}
+			if err != nil {
+				return
+			}
+			body, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("json.Marshal(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want.body, string(body)); diff != "" {
+				t.Fatalf("convertPorts(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_canonicalJSON(t *testing.T) {
+	type want struct {
+		body string
+		err  error
+	}
+
+	cases := map[string]struct {
+		// bodies are encodings of the same logical payload.
+		bodies []string
+		want   want
+	}{
+		"Empty": {
+			bodies: []string{""},
+			want:   want{body: ""},
+		},
+		"KeysSorted": {
+			bodies: []string{
+				`{"order":{"source":"10.0.0.0/24","destination":"10.0.1.0/24","ports":[{"protocol":"TCP","port":443}]}}`,
+				`{"order":{"ports":[{"port":443,"protocol":"TCP"}],"destination":"10.0.1.0/24","source":"10.0.0.0/24"}}`,
+				"{\n  \"order\": {\n    \"destination\": \"10.0.1.0/24\",\n    \"source\": \"10.0.0.0/24\",\n    \"ports\": [{\"protocol\": \"TCP\", \"port\": 443}]\n  }\n}",
+			},
+			want: want{body: `{"order":{"destination":"10.0.1.0/24","ports":[{"port":443,"protocol":"TCP"}],"source":"10.0.0.0/24"}}`},
+		},
+		"ValuesPreserved": {
+			bodies: []string{`{"z":12345678901234567890,"a":1.50,"m":"<a&b>"}`},
+			want:   want{body: `{"a":1.50,"m":"<a&b>","z":12345678901234567890}`},
+		},
+		"Invalid": {
+			bodies: []string{`{"order":`},
+			want:   want{err: errors.Wrap(errors.New("unexpected EOF"), errCanonicalBody)},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			for _, body := range tc.bodies {
+				// Map iteration order varies between runs, so encode each
+				// body repeatedly.
+				for i := 0; i < 20; i++ {
+					got, err := canonicalJSON(body)
+					if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+						t.Fatalf("canonicalJSON(%s): -want error, +got error: %s", body, diff)
+					}
+					if diff := cmp.Diff(tc.want.body, got); diff != "" {
+						t.Fatalf("canonicalJSON(%s): -want, +got: %s", body, diff)
+					}
+				}
+			}
+		})
+	}
+}
+
+func Test_external_Create_Justification(t *testing.T) {
+	ref := &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Namespace: "compliance", Name: "chg-42"},
+		Key:             "justification",
+	}
+
+	type want struct {
+		justification string
+		sent          bool
+		err           error
+	}
+
+	cases := map[string]struct {
+		ref    *xpv1.SecretKeySelector
+		secret *corev1.Secret
+		want   want
+	}{
+		"NotConfigured": {
+			want: want{sent: true},
+		},
+		"Resolved": {
+			ref: ref,
+			secret: &corev1.Secret{Data: map[string][]byte{
+				"justification": []byte("Jenkins agents need to reach the build cluster.\nApproved in CAB-17."),
+			}},
+			want: want{
+				justification: "Jenkins agents need to reach the build cluster.\nApproved in CAB-17.",
+				sent:          true,
+			},
+		},
+		"SecretMissing": {
+			ref: ref,
+			want: want{err: errors.Wrapf(
+				kerrors.NewNotFound(corev1.Resource("secrets"), "chg-42"),
+				errGetJustification, "compliance", "chg-42")},
+		},
+		"KeyMissing": {
+			ref:    ref,
+			secret: &corev1.Secret{Data: map[string][]byte{"other": nil}},
+			want:   want{err: errors.Errorf(errJustificationNoKey, "compliance", "chg-42", "justification")},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			e := &external{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+						if tc.secret == nil {
+							return kerrors.NewNotFound(corev1.Resource("secrets"), key.Name)
+						}
+						tc.secret.DeepCopyInto(obj.(*corev1.Secret))
+						return nil
+					},
+				},
+				client: &MockHttpClient{MockSendRequest: func(_ context.Context, _ string, _ string, body httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+					got.sent = true
+					req := OrderRequest{}
+					_ = json.Unmarshal([]byte(body.Decrypted.(string)), &req)
+					got.justification = req.Order.Justification
+					return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 201, Body: `{"orderId":"order-123","status":"pending"}`}}, nil
+				}},
+				logger: logging.NewNopLogger(),
+			}
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.JustificationSecretRef = tc.ref
+			})
+
+			_, got.err = e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors(), cmp.AllowUnexported(want{})); diff != "" {
+				t.Fatalf("e.Create(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Create_Environment(t *testing.T) {
+	type want struct {
+		body string
+		err  error
+	}
+
+	cases := map[string]struct {
+		environment string
+		want        want
+	}{
+		"NotSet": {
+			want: want{body: `{"order":{"source":"10.0.0.0/24","destination":"10.0.1.0/24","ports":[{"protocol":"TCP","port":443}],"direction":"ingress"}}`},
+		},
+		"Stage": {
+			environment: v1alpha1.EnvironmentStage,
+			want:        want{body: `{"order":{"source":"10.0.0.0/24","destination":"10.0.1.0/24","ports":[{"protocol":"TCP","port":443}],"direction":"ingress","environment":"stage"}}`},
+		},
+		"Unknown": {
+			environment: "qa",
+			want:        want{err: errors.Errorf(errEnvironmentInvalid, "qa")},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: func(_ context.Context, _ string, _ string, body httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+					got.body = body.Decrypted.(string)
+					return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 201, Body: `{"orderId":"order-123","status":"pending"}`}}, nil
+				}},
+				logger: logging.NewNopLogger(),
+			}
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.Environment = tc.environment
+			})
+
+			_, got.err = e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors(), cmp.AllowUnexported(want{})); diff != "" {
+				t.Fatalf("e.Create(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Create_MaxRequestBytes(t *testing.T) {
+	body := `{"order":{"source":"10.0.0.0/24","destination":"10.0.1.0/24","ports":[{"protocol":"TCP","port":443}],"direction":"ingress"}}`
+	size := int64(len(body))
+
+	type want struct {
+		sent     bool
+		rejected int64
+		err      error
+	}
+
+	cases := map[string]struct {
+		limit *int64
+		want  want
+	}{
+		"Default": {
+			want: want{sent: true},
+		},
+		"AtLimit": {
+			limit: ptr.To(size),
+			want:  want{sent: true},
+		},
+		"OverLimit": {
+			limit: ptr.To(size - 1),
+			want:  want{rejected: 3, err: errors.Errorf(errRequestTooLarge, size, size-1)},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: func(_ context.Context, _ string, _ string, _ httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+					got.sent = true
+					return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 201, Body: `{"orderId":"order-123","status":"pending"}`}}, nil
+				}},
+				logger: logging.NewNopLogger(),
+			}
+			cr := portOrder(withGeneration(3), func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.MaxRequestBytes = tc.limit
+			})
+
+			_, got.err = e.Create(context.Background(), cr)
+			got.rejected = cr.Status.AtProvider.RejectedGeneration
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors(), cmp.AllowUnexported(want{})); diff != "" {
+				t.Fatalf("e.Create(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
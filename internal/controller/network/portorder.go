@@ -4,16 +4,23 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
@@ -22,10 +29,9 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
-	"github.com/crossplane/provider-http/apis/network/v1alpha1"
-	apisv1alpha1 "github.com/crossplane/provider-http/apis/v1alpha1"
-	httpclient "github.com/crossplane/provider-http/internal/clients/http"
-	"github.com/crossplane/provider-http/internal/features"
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	apisv1alpha1 "github.com/crossplane-contrib/provider-http/apis/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
 )
 
 const (
@@ -37,39 +43,231 @@ const (
 	errNewClient = "cannot create new HTTP client"
 	errMarshal   = "cannot marshal request body"
 	errUnmarshal = "cannot unmarshal response"
+	errGetToken  = "cannot get OAuth2 token"
+	errSignURL   = "cannot sign request URL"
+	errObserve   = "failed to observe order"
+	errNoOrder   = "response does not contain an order"
+	errNotFound  = "created order %s was not found by the backend"
+
+	errRequestTooLarge = "create request body of %d bytes exceeds the maximum size of %d bytes"
+
+	errEndpointPlaceholder = "endpoint %q contains an unresolved template placeholder"
+	errEndpointInvalid     = "endpoint %q is not a valid URL"
+	errEndpointScheme      = "endpoint %q must use the http or https scheme"
+	errEndpointHost        = "endpoint %q has no host"
 )
 
+const authKey = "Authorization"
+
+// defaultMaxResponseBytes is the response body limit applied when a PortOrder
+// does not set one.
+const defaultMaxResponseBytes int64 = 10 << 20
+
+// defaultMaxRequestBytes is the create request body limit applied when a
+// PortOrder does not set one. It is the request size limit documented by the
+// orders API.
+const defaultMaxRequestBytes int64 = 1 << 20
+
+// defaultNotFoundGracePeriod is how long after it was submitted an order the
+// backend does not find is still considered to exist.
+const defaultNotFoundGracePeriod = time.Minute
+
 // OrderRequest represents the API request format
 type OrderRequest struct {
 	Order OrderPayload `json:"order"`
+	// Fields are additional top-level fields of the request body, read from
+	// the body field annotations of the order.
+	Fields map[string]interface{} `json:"-"`
 }
 
 // OrderPayload represents the order details
 type OrderPayload struct {
-	Source      string      `json:"source"`
-	Destination string      `json:"destination"`
-	Ports       []PortEntry `json:"ports"`
+	Source      string            `json:"source"`
+	Destination string            `json:"destination"`
+	Ports       []PortEntry       `json:"ports"`
+	Direction   string            `json:"direction"`
+	Environment string            `json:"environment,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	Tenant      string            `json:"tenant,omitempty"`
+	GroupID     string            `json:"groupId,omitempty"`
+	// Justification is the compliance justification of the order, read from
+	// its JustificationSecretRef.
+	Justification string `json:"justification,omitempty"`
+	// RolloutPercentage is the percentage of matching devices the order is
+	// applied to.
+	RolloutPercentage *int `json:"rolloutPercentage,omitempty"`
 }
 
 // PortEntry represents a port in the API format
 type PortEntry struct {
-	Protocol string `json:"protocol"`
-	Port     int    `json:"port"`
+	// Protocol is the protocol name or IP protocol number, depending on the
+	// ProtocolFormat of the order.
+	Protocol interface{} `json:"protocol"`
+	// Port is omitted for protocols without ports, such as ICMP.
+	Port *int `json:"port,omitempty"`
+	// EndPort is only reported by backends that open Port to EndPort as a
+	// range.
+	EndPort *int   `json:"endPort,omitempty"`
+	Label   string `json:"label,omitempty"`
+	Comment string `json:"comment,omitempty"`
 }
 
 // OrderResponse represents the API response format
 type OrderResponse struct {
-	OrderID string `json:"orderId"`
-	Status  string `json:"status"`
+	OrderID           jsonIDs         `json:"orderId"`
+	Status            string          `json:"status"`
+	Source            string          `json:"source,omitempty"`
+	Destination       string          `json:"destination,omitempty"`
+	RuleIDs           jsonIDs         `json:"ruleIds,omitempty"`
+	PollAfterSeconds  *int64          `json:"pollAfterSeconds,omitempty"`
+	AppliedPercentage *int            `json:"appliedPercentage,omitempty"`
+	AffectedDevices   int             `json:"affectedDevices,omitempty"`
+	Approvals         []orderApproval `json:"approvals,omitempty"`
+	Reason            string          `json:"reason,omitempty"`
+	LastModified      string          `json:"lastModified,omitempty"`
+	Ports             []PortEntry     `json:"ports,omitempty"`
+	Cursor            string          `json:"cursor,omitempty"`
+	Zone              string          `json:"zone,omitempty"`
+	StatusChangedAt   string          `json:"statusChangedAt,omitempty"`
+	NextAction        string          `json:"nextAction,omitempty"`
+	// Receipt is the base64-encoded signed receipt of the order, which is
+	// not interpreted.
+	Receipt string `json:"receipt,omitempty"`
+	// Tags are the tags the backend reports for the order. Their values
+	// need not be strings.
+	Tags map[string]interface{} `json:"tags,omitempty"`
+	// Version identifies the revision of the order, which modifications of
+	// the order must match. It is read from the ETag header if the body does
+	// not have it.
+	Version string `json:"version,omitempty"`
+}
+
+// Options configures the PortOrder controller beyond the options shared by
+// all controllers.
+type Options struct {
+	// FinalizerName is the finalizer added to PortOrders. Defaults to the
+	// managed resource finalizer.
+	FinalizerName string
+
+	// LegacyFinalizerNames are the finalizers PortOrders were previously
+	// created with. They are removed along with FinalizerName when a
+	// PortOrder is deleted, so that changing FinalizerName does not block
+	// the deletion of existing PortOrders. The default finalizer is always
+	// removed.
+	LegacyFinalizerNames []string
+
+	// TracerProvider provides the tracer that records a span per PortOrder
+	// operation. Defaults to the global provider.
+	TracerProvider trace.TracerProvider
+
+	// MultiTenant rejects PortOrders that do not set a TenantID.
+	MultiTenant bool
+
+	// TenantIDPattern validates the TenantID of PortOrders. Defaults to a
+	// UUID.
+	TenantIDPattern *regexp.Regexp
+
+	// ObserveCacheTTL is how long an order read from the backend is reused
+	// by later observations instead of being read again, as long as the spec
+	// of its PortOrder is unchanged. Orders are not cached unless it is
+	// positive.
+	ObserveCacheTTL time.Duration
+
+	// MaxConcurrentWrites is the maximum number of requests that create,
+	// update or cancel orders sent at once, across all PortOrders. Reads are
+	// not limited. Writes are not limited unless it is positive.
+	MaxConcurrentWrites int
+
+	// CreateDedupWindow is how long after an order was created a create of
+	// the same PortOrder with an unchanged spec is suppressed, returning the
+	// order created before. This guards against reconciles that race the
+	// status update of a create. Creates are not deduplicated unless it is
+	// positive.
+	CreateDedupWindow time.Duration
+
+	// LatencyWindow is how long the response times of the orders API are
+	// kept to export their p50, p95 and p99 per endpoint. Response times are
+	// not tracked unless it is positive.
+	LatencyWindow time.Duration
+
+	// ObserveOnResync reconciles every PortOrder on each periodic resync of
+	// the cache, even though its desired state did not change.
+	ObserveOnResync bool
+
+	// RedactPatterns match sensitive text of backend error messages, which
+	// is redacted before it is stored in the status of a PortOrder or
+	// emitted as an event. Only the subexpression named "secret" of a
+	// pattern is redacted, if it has one. Common tokens and secrets are
+	// always redacted.
+	RedactPatterns []*regexp.Regexp
+
+	// ReservedPorts are port ranges orders may not open unless they are
+	// privileged by the AnnotationKeyPrivileged annotation. Creating such
+	// an order fails without it being submitted.
+	ReservedPorts []PortRange
+
+	// ConnectBackoff is the delay after which a PortOrder that failed to
+	// connect to the backend, e.g. because its ProviderConfig or credentials
+	// are missing, is reconciled again. It doubles with every failure in a
+	// row up to ConnectBackoffMax, and is reset once connecting succeeds.
+	// Such PortOrders are requeued like any other failing reconcile unless
+	// it is positive.
+	ConnectBackoff time.Duration
+
+	// ConnectBackoffMax is the maximum delay of ConnectBackoff. The delay is
+	// not limited unless it is positive.
+	ConnectBackoffMax time.Duration
+
+	// PriorityDeferral is the delay after which a PortOrder is reconciled
+	// again when it yields to a pending PortOrder of a higher priority,
+	// i.e. one that is being created rather than parked, e.g. awaiting
+	// approvals. A PortOrder yields at most PriorityMaxDeferrals times in a
+	// row, so PortOrders of a lower priority are slowed down but never
+	// starved. PortOrders are reconciled in the order they were queued unless
+	// both are positive.
+	PriorityDeferral     time.Duration
+	PriorityMaxDeferrals int
+
+	// HealthURL is a health endpoint of the orders backend. If set, it is
+	// probed every HealthInterval, and the controller is only ready while
+	// the last probe reached the backend.
+	HealthURL string
+
+	// HealthInterval is how often HealthURL is probed.
+	HealthInterval time.Duration
 }
 
 // Setup adds a controller that reconciles PortOrder managed resources.
-func Setup(mgr ctrl.Manager, o controller.Options) error {
+func Setup(mgr ctrl.Manager, o controller.Options, timeout time.Duration, no Options) error {
 	name := managed.ControllerName(v1alpha1.PortOrderGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
-	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
-		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+
+	events, err := newEventPublisher(o.Logger.WithValues("controller", name))
+	if err != nil {
+		return errors.Wrap(err, errNewClient)
+	}
+	redactor := newRedactor(no.RedactPatterns...)
+
+	backoff := newConnectBackoff(no.ConnectBackoff, no.ConnectBackoffMax)
+	priorities := newPriorityQueue(no.PriorityDeferral, no.PriorityMaxDeferrals)
+
+	latencies := newLatencyTracker(no.LatencyWindow)
+	if latencies != nil {
+		if err := metrics.Registry.Register(latencies); err != nil {
+			return errors.Wrap(err, errRegisterMetrics)
+		}
+	}
+
+	if no.HealthURL != "" {
+		p := newBackendProbe(no.HealthURL, no.HealthInterval)
+		if err := mgr.Add(p); err != nil {
+			return errors.Wrap(err, errAddHealthProbe)
+		}
+		if err := mgr.AddReadyzCheck(healthCheckName, p.Check); err != nil {
+			return errors.Wrap(err, errAddHealthProbe)
+		}
 	}
 
 	r := managed.NewReconciler(mgr,
@@ -79,19 +277,42 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 			usage:           resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
 			logger:          o.Logger,
 			newHttpClientFn: httpclient.NewClient,
+			clients:         newClientCache(),
+			tokens:          newTokenCache(),
+			signedURLs:      newTokenCache(),
+			streams:         newStreamManager(mgr.GetClient(), o.Logger.WithValues("controller", name), redactor),
+			tracer:          tracer(no.TracerProvider),
+			tenancy:         tenancy{required: no.MultiTenant, pattern: no.TenantIDPattern},
+			orders:          newOrderCache(no.ObserveCacheTTL),
+			creates:         newCreateDeduper(no.CreateDedupWindow),
+			schemas:         newSchemaCache(),
+			writes:          newWriteLimiter(no.MaxConcurrentWrites),
+			events:          events,
+			redactor:        redactor,
+			recorder:        recorder,
+			latencies:       latencies,
+			reservedPorts:   no.ReservedPorts,
+			backoff:         backoff,
+			balancer:        newEndpointBalancer(),
 		}),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		WithPollIntervalHook(),
+		managed.WithTimeout(timeout),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &groupLabeler{kube: mgr.GetClient()}),
+		managed.WithFinalizer(newFinalizer(mgr.GetClient(), no.FinalizerName, no.LegacyFinalizerNames...)),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(resource.DesiredStateChanged()).
+		WithEventFilter(priorities.tracker()).
+		WithEventFilter(backoff.tracker()).
+		WithEventFilter(eventFilter(no.ObserveOnResync)).
 		For(&v1alpha1.PortOrder{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(withPriority(ratelimiter.NewReconciler(name, withConnectBackoff(r, backoff), o.GlobalRateLimiter), priorities))
 }
 
 // connector is expected to produce an ExternalClient when its Connect method
@@ -100,7 +321,24 @@ type connector struct {
 	kube            client.Client
 	usage           resource.Tracker
 	logger          logging.Logger
-	newHttpClientFn func(log logging.Logger, timeout time.Duration, creds string) (httpclient.Client, error)
+	newHttpClientFn func(log logging.Logger, timeout time.Duration, creds string, opts ...httpclient.ClientOption) (httpclient.Client, error)
+	clients         *clientCache
+	tokens          *tokenCache
+	signedURLs      *tokenCache
+	streams         *streamManager
+	tracer          trace.Tracer
+	tenancy         tenancy
+	orders          *orderCache
+	creates         *createDeduper
+	schemas         *schemaCache
+	writes          *writeLimiter
+	events          *eventPublisher
+	redactor        *redactor
+	recorder        event.Recorder
+	latencies       *latencyTracker
+	reservedPorts   []PortRange
+	backoff         *connectBackoff
+	balancer        *endpointBalancer
 }
 
 // Connect produces an ExternalClient for PortOrder resources.
@@ -110,12 +348,22 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.New(errNotPortOrder)
 	}
 
+	ext, err := c.connect(ctx, cr)
+	c.recordConnect(cr, err)
+	return ext, err
+}
+
+func (c *connector) connect(ctx context.Context, cr *v1alpha1.PortOrder) (managed.ExternalClient, error) {
 	l := c.logger.WithValues("portOrder", cr.Name)
 
-	if err := c.usage.Track(ctx, mg); err != nil {
+	if err := c.usage.Track(ctx, cr); err != nil {
 		return nil, errors.Wrap(err, errTrackPCUsage)
 	}
 
+	if err := c.tenancy.validate(cr.Spec.ForProvider.TenantID); err != nil {
+		return nil, err
+	}
+
 	pc := &apisv1alpha1.ProviderConfig{}
 	n := types.NamespacedName{Name: cr.GetProviderConfigReference().Name}
 	if err := c.kube.Get(ctx, n, pc); err != nil {
@@ -123,22 +371,34 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	}
 
 	var creds string = ""
-	if pc.Spec.Credentials.Source == xpv1.CredentialsSourceSecret {
+	// credsSource identifies where the credentials come from.
+	credsSource := "providerconfig/" + pc.GetName()
+	switch {
+	case cr.Spec.ForProvider.CredentialsSecretRef != nil:
+		ref := cr.Spec.ForProvider.CredentialsSecretRef
+		data, err := orderCredentials(ctx, c.kube, *ref)
+		if err != nil {
+			return nil, err
+		}
+		creds = data
+		credsSource = "secret/" + ref.Namespace + "/" + ref.Name + "/" + ref.Key
+	case pc.Spec.Credentials.Source == xpv1.CredentialsSourceSecret:
 		data, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, c.kube, pc.Spec.Credentials.CommonCredentialSelectors)
 		if err != nil {
 			return nil, errors.Wrap(err, errGetCreds)
 		}
 		creds = string(data)
+	case pc.Spec.Credentials.Source == xpv1.CredentialsSourceEnvironment:
+		data, err := envCredentials(pc.Spec.Credentials.CommonCredentialSelectors)
+		if err != nil {
+			return nil, err
+		}
+		creds = data
+		credsSource = "env/" + pc.Spec.Credentials.Env.Name
 	}
 
 	// Parse credentials to get auth info
-	config := struct {
-		AuthType    string            `json:"authType,omitempty"`
-		Credentials string            `json:"credentials,omitempty"`
-		Headers     map[string]string `json:"headers,omitempty"`
-		Timeout     *time.Duration    `json:"timeout,omitempty"`
-	}{}
-
+	config := credentialsConfig{}
 	if len(creds) > 0 {
 		if err := json.Unmarshal([]byte(creds), &config); err != nil {
 			return nil, errors.Wrap(err, "failed to parse credentials")
@@ -151,24 +411,202 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		timeout = *config.Timeout
 	}
 
-	// Create HTTP client
-	h, err := c.newHttpClientFn(l, timeout, creds)
+	// OAuth2 tokens are attached per request rather than as a static token,
+	// so that they can be refreshed when the backend rejects them.
+	token := config.Credentials
+	if config.AuthType == authTypeOAuth2 {
+		if config.TokenURL == "" {
+			return nil, errors.New(errTokenURLRequired)
+		}
+		token = ""
+	}
+	if config.AuthType == authTypeSignedURL && config.SigningURL == "" {
+		return nil, errors.New(errSigningURLRequired)
+	}
+	if config.AuthType == authTypeHMAC && config.SigningKey == "" {
+		return nil, errors.New(errSigningKeyRequired)
+	}
+	serverName := ""
+	if config.TLSServerName != nil {
+		if serverName = strings.TrimSpace(*config.TLSServerName); serverName == "" {
+			return nil, errors.New(errTLSServerNameEmpty)
+		}
+	}
+
+	// Reuse the HTTP client of earlier reconciles with the same credentials,
+	// which keeps its connections alive. It is shared by the orders using
+	// the credentials, so it logs without the name of the order.
+	limit := maxResponseBytes(cr.Spec.ForProvider)
+	follow := followsRedirects(cr.Spec.ForProvider)
+	h, err := c.clients.get(fmt.Sprintf("%s|%d|%t", credsSource, limit, follow), creds, func() (httpclient.Client, error) {
+		return c.newHttpClientFn(c.logger, timeout, token,
+			httpclient.WithMaxResponseBytes(limit),
+			httpclient.WithExactHeaderCasing(config.ExactCaseHeaders...),
+			httpclient.WithTLSServerName(serverName),
+			httpclient.WithFollowRedirects(follow))
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
-	return &external{
+	e := &external{
+		kube:           c.kube,
 		client:         h,
 		logger:         l,
 		defaultHeaders: config.Headers,
-	}, nil
+		tenantID:       cr.Spec.ForProvider.TenantID,
+		token:          token,
+		streams:        c.streams,
+		orders:         c.orders,
+		creates:        c.creates,
+		schemas:        c.schemas,
+		writes:         c.writes,
+		events:         c.events,
+		redactor:       c.redactor,
+		recorder:       c.recorder,
+		latencies:      c.latencies,
+		reservedPorts:  c.reservedPorts,
+		retryBackoff:   defaultRetryBackoff,
+		endpoints:      c.balancer.pool(cr.Spec.ForProvider),
+	}
+	if config.AuthType == authTypeOAuth2 {
+		e.tokens = &tokenSource{client: h, cache: c.tokens, config: config}
+	}
+	if config.AuthType == authTypeSignedURL {
+		e.signer = &urlSigner{client: h, cache: c.signedURLs, config: config}
+	}
+	if config.AuthType == authTypeHMAC {
+		e.hmac = &hmacSigner{primary: config.SigningKey, secondary: config.SecondarySigningKey}
+	}
+	if cr.Spec.ForProvider.EncryptPayload {
+		if e.envelope, err = newPayloadEnvelope(config); err != nil {
+			return nil, err
+		}
+	}
+
+	// Errors are redacted before they are traced.
+	var ec managed.ExternalClient = e
+	if c.redactor != nil {
+		ec = &redactedExternal{ExternalClient: ec, redactor: c.redactor}
+	}
+	if c.tracer != nil {
+		return &tracedExternal{ExternalClient: ec, tracer: c.tracer}, nil
+	}
+	return ec, nil
 }
 
 // external manages the external API operations for PortOrder resources.
 type external struct {
+	kube           client.Client
 	client         httpclient.Client
 	logger         logging.Logger
 	defaultHeaders map[string]string
+	tenantID       string
+	token          string
+	tokens         *tokenSource
+	signer         *urlSigner
+	hmac           *hmacSigner
+	streams        *streamManager
+	orders         *orderCache
+	creates        *createDeduper
+	schemas        *schemaCache
+	writes         *writeLimiter
+	events         *eventPublisher
+	redactor       *redactor
+	recorder       event.Recorder
+	latencies      *latencyTracker
+	reservedPorts  []PortRange
+	// endpoints are the equivalent endpoints of the orders API requests are
+	// spread across, if any.
+	endpoints *endpointPool
+	// redirectedURL is where the orders API last redirected a request to,
+	// without its query.
+	redirectedURL string
+	// responded is whether the orders API responded to any request, and
+	// deprecation is the last deprecation it announced in a response.
+	responded   bool
+	deprecation *deprecation
+	// envelope encrypts request bodies and decrypts responses, if the
+	// order encrypts its payloads.
+	envelope *payloadEnvelope
+	// retryBackoff is the initial wait before an order the backend asked to
+	// retry is submitted again.
+	retryBackoff time.Duration
+}
+
+// createEndpoint returns the endpoint orders are submitted to.
+func createEndpoint(p v1alpha1.PortOrderParameters) string {
+	if p.CreateEndpoint != "" {
+		return p.CreateEndpoint
+	}
+	return p.APIEndpoint
+}
+
+// createOnly reports whether the order is only observed until it was
+// created.
+func createOnly(p v1alpha1.PortOrderParameters) bool {
+	return p.ObserveMode == v1alpha1.ObserveModeCreateOnly
+}
+
+// observeEndpoint returns the endpoint orders are read from.
+func observeEndpoint(p v1alpha1.PortOrderParameters) string {
+	if p.ObserveEndpoint != "" {
+		return p.ObserveEndpoint
+	}
+	return p.APIEndpoint
+}
+
+// validateEndpoint checks that the endpoint is an absolute http or https URL
+// without leftover template placeholders.
+func validateEndpoint(endpoint string) error {
+	if strings.Contains(endpoint, "{{") || strings.Contains(endpoint, "}}") {
+		return errors.Errorf(errEndpointPlaceholder, endpoint)
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return errors.Wrapf(err, errEndpointInvalid, endpoint)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.Errorf(errEndpointScheme, endpoint)
+	}
+
+	if u.Hostname() == "" {
+		return errors.Errorf(errEndpointHost, endpoint)
+	}
+
+	return nil
+}
+
+// orderURL returns the URL of a single order below the given endpoint.
+func orderURL(endpoint, orderID string) string {
+	return strings.TrimSuffix(endpoint, "/") + "/" + url.PathEscape(orderID)
+}
+
+// orderIDs returns the IDs of all rule objects of the order.
+func orderIDs(cr *v1alpha1.PortOrder) []string {
+	if ids := cr.Status.AtProvider.OrderIDs; len(ids) > 0 {
+		return ids
+	}
+	return []string{cr.Status.AtProvider.OrderID}
+}
+
+// maxRequestBytes returns the create request body limit for the supplied
+// order.
+func maxRequestBytes(p v1alpha1.PortOrderParameters) int64 {
+	if p.MaxRequestBytes != nil {
+		return *p.MaxRequestBytes
+	}
+	return defaultMaxRequestBytes
+}
+
+// maxResponseBytes returns the response body limit for the supplied order.
+func maxResponseBytes(p v1alpha1.PortOrderParameters) int64 {
+	if p.MaxResponseBytes != nil {
+		return *p.MaxResponseBytes
+	}
+	return defaultMaxResponseBytes
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -176,101 +614,646 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotPortOrder)
 	}
+	defer e.recordRedirect(cr)
+	defer e.recordDeprecation(cr)
+
+	// A paused order is reported as existing and up to date without asking
+	// the backend, so that it is neither created, updated nor deleted.
+	if paused(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	// An order created outside of Crossplane is adopted by naming it in the
+	// external name. It is read from the backend rather than created.
+	imported := false
+	if id := importedOrderID(cr); id != "" && !meta.WasDeleted(cr) {
+		e.logger.Debug("Importing existing PortOrder", "name", cr.GetName(), "orderID", id)
+		cr.Status.AtProvider.OrderID = id
+		imported = true
+	}
+
+	// A queued order is observed through the result of its job until the
+	// job completes.
+	if q := cr.Spec.ForProvider.Queue; q != nil && cr.Status.AtProvider.OrderID == "" && cr.Status.AtProvider.Ticket != "" && !meta.WasDeleted(cr) {
+		obs, done, err := e.observeTicket(ctx, cr, *q)
+		if err != nil || !done {
+			return obs, err
+		}
+	}
+
+	// An order is submitted again once for each new value of its
+	// force-resync annotation.
+	if !meta.WasDeleted(cr) && e.forceResync(cr) {
+		e.logger.Debug("Forcing resubmission of PortOrder", "name", cr.GetName(), "orderID", cr.Status.AtProvider.OrderID)
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
 
 	// If we don't have an order ID, the resource doesn't exist externally
 	if cr.Status.AtProvider.OrderID == "" {
+		if meta.WasDeleted(cr) {
+			return managed.ExternalObservation{
+				ResourceExists: false,
+			}, nil
+		}
+
+		if rejected(cr) {
+			cr.SetConditions(rejectedCondition(cr))
+			return managed.ExternalObservation{
+				ResourceExists:   true,
+				ResourceUpToDate: true,
+			}, nil
+		}
+
+		// Report an order that must not be submitted yet as existing, so
+		// the reconcile is requeued without attempting to create it.
+		ready, err := e.readyToCreate(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
 		return managed.ExternalObservation{
-			ResourceExists: false,
+			ResourceExists:   !ready,
+			ResourceUpToDate: !ready,
 		}, nil
 	}
 
+	// No retry fixes an invalid endpoint, so an order that is not being
+	// deleted is reported as up to date until its spec changes rather than
+	// failing every observation.
+	endpoint := observeEndpoint(cr.Spec.ForProvider)
+	if err := validateEndpoint(endpoint); err != nil {
+		if meta.WasDeleted(cr) {
+			return managed.ExternalObservation{}, err
+		}
+		cr.SetConditions(v1alpha1.InvalidEndpoint(err.Error()))
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	// Once the order was cancelled, confirm that the backend removed it.
+	if meta.WasDeleted(cr) && cr.Status.AtProvider.CancelRequestTime != nil {
+		return e.observeDeletion(ctx, cr, endpoint)
+	}
+
+	// An order past its maximum lifetime is cancelled. It is then reported
+	// as existing and up to date, so that it is not submitted again.
+	if expired, err := e.expire(ctx, cr, time.Now()); expired || err != nil {
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, err
+	}
+
+	// An order observed on create only is not read from the backend again,
+	// other than when it is imported. It is up to date unless its source or
+	// destination changed since it was submitted.
+	if createOnly(cr.Spec.ForProvider) && !imported {
+		setAvailable(cr, time.Now())
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: !endpointsDrifted(cr),
+		}, nil
+	}
+
+	// Order updates arrive over the WebSocket subscription while it is
+	// connected. The order is polled again if it disconnects.
+	if cr.Spec.ForProvider.WatchViaWebSocket && e.streams != nil && e.streams.Connected(cr.GetUID()) {
+		if err := e.setReadiness(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if recordFinalizationReport(cr, time.Now()) {
+			e.publishEvent(ctx, cr, finalEvent(cr))
+			e.notify(ctx, cr)
+		}
+		_, step := nextRolloutPercentage(cr, time.Now())
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: !step,
+		}, nil
+	}
+
+	// A ready order may only be checked to still exist, unless the backend
+	// does not support HEAD requests.
+	if checksExistenceWithHEAD(cr) {
+		exists, supported, err := e.headOrders(ctx, cr, endpoint)
+		if obs, ok := rejectedObservation(cr, err); ok {
+			return obs, nil
+		}
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if supported {
+			if !exists {
+				return e.notFound(cr, time.Now()), nil
+			}
+			if err := e.setReadiness(ctx, cr); err != nil {
+				return managed.ExternalObservation{}, err
+			}
+			_, step := nextRolloutPercentage(cr, time.Now())
+			return managed.ExternalObservation{
+				ResourceExists:   true,
+				ResourceUpToDate: !step && !endpointsDrifted(cr) && !reassertPorts(cr),
+			}, nil
+		}
+	}
+
 	// Check the status of the existing order
-	// For now, we'll consider the resource to exist if we have an order ID
-	// In a real implementation, you might want to GET the order status from the API
+	orderResp, err := e.getOrders(ctx, cr, endpoint)
+	if obs, ok := rejectedObservation(cr, err); ok {
+		return obs, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if orderResp == nil {
+		// An imported order that does not exist is not created in its
+		// place.
+		if imported {
+			cr.Status.AtProvider.OrderID = ""
+			return managed.ExternalObservation{}, errors.Errorf(errImportNotFound, meta.GetExternalName(cr))
+		}
+		return e.notFound(cr, time.Now()), nil
+	}
+	recordStatusAge(cr, orderResp.Status, orderResp.StatusChangedAt, time.Now())
+	cr.Status.AtProvider.Status = orderResp.Status
+	cr.Status.AtProvider.StatusReason = e.redactor.redact(orderResp.Reason)
+	cr.Status.AtProvider.NextAction = orderResp.NextAction
+	e.recordReceipt(cr, orderResp.Receipt)
+	cr.Status.AtProvider.BackendTags = backendTags(orderResp.Tags)
+	cr.Status.AtProvider.LastModifiedByBackend = lastModified(orderResp.LastModified)
+	cr.Status.AtProvider.PollAfterSeconds = orderResp.PollAfterSeconds
+	cr.Status.AtProvider.AppliedPercentage = orderResp.AppliedPercentage
+	cr.Status.AtProvider.AffectedDevices = orderResp.AffectedDevices
+	// The zone may not be reported until the order is placed. A zone
+	// already observed is kept until another one is reported.
+	if orderResp.Zone != "" {
+		cr.Status.AtProvider.Zone = orderResp.Zone
+	}
+	cr.Status.AtProvider.Source = orderResp.Source
+	cr.Status.AtProvider.Destination = orderResp.Destination
+	// Backends may report the device rules only once they exist, or not at
+	// all. Rule IDs already observed are kept until others are reported.
+	if len(orderResp.RuleIDs) > 0 {
+		cr.Status.AtProvider.RuleIDs = orderResp.RuleIDs
+	}
+	recordApprovals(cr, orderResp.Approvals)
+	e.recordPorts(cr, orderResp.Ports)
+
+	if readsHistory(cr.Spec.ForProvider) {
+		if err := e.readHistory(ctx, cr, endpoint); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
+	// Failed orders are not probed, since they do not become ready.
+	if probesReadiness(cr.Spec.ForProvider) && !isFailed(cr) {
+		ready, err := e.probeReadiness(ctx, cr, endpoint)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		cr.Status.AtProvider.ReadinessProbeSucceeded = ready
+	}
+
+	if err := e.setReadiness(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if recordFinalizationReport(cr, time.Now()) {
+		e.publishEvent(ctx, cr, finalEvent(cr))
+		e.notify(ctx, cr)
+	}
+
+	if !isGraphQL(cr.Spec.ForProvider) {
+		if err := e.syncStream(cr, endpoint); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
+	// Port orders are typically one-time requests, unless they are rolled
+	// out progressively, their source or destination changed, or their ports
+	// were changed at the backend and are reasserted.
+	_, step := nextRolloutPercentage(cr, time.Now())
+
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: true, // Port orders are typically one-time requests
+		ResourceUpToDate: !step && !endpointsDrifted(cr) && !reassertPorts(cr),
 	}, nil
 }
 
+// getOrders reads every rule object of the order from the backend and
+// combines them into one order. It returns nil if the backend does not find
+// any of them.
+func (e *external) getOrders(ctx context.Context, cr *v1alpha1.PortOrder, endpoint string) (*OrderResponse, error) {
+	// Orders are not cached if the spec cannot be hashed, since a change of
+	// the spec could not be told apart.
+	hash, cacheable := specHash(cr.Spec.ForProvider)
+	orders := make([]*OrderResponse, 0, len(orderIDs(cr)))
+	for _, id := range orderIDs(cr) {
+		if order, ok := e.orders.get(id, hash, time.Now()); ok {
+			recordVersion(cr, id, order.Version)
+			orders = append(orders, order)
+			continue
+		}
+		order, err := e.getOrder(ctx, cr, endpoint, id)
+		if err != nil || order == nil {
+			return nil, err
+		}
+		if cacheable {
+			e.orders.set(id, hash, *order, time.Now())
+		}
+		recordVersion(cr, id, order.Version)
+		orders = append(orders, order)
+	}
+	return combineOrders(readyStatuses(cr.Spec.ForProvider), orders), nil
+}
+
+// getOrder reads the order with the supplied ID from the backend. It returns
+// nil if the backend does not find the order.
+func (e *external) getOrder(ctx context.Context, cr *v1alpha1.PortOrder, endpoint, id string) (*OrderResponse, error) {
+	var details httpclient.HttpDetails
+	var err error
+	cursor := deltaCursor(cr)
+	if isGraphQL(cr.Spec.ForProvider) {
+		details, err = e.sendGraphQL(ctx, endpoint, observeQuery(cr.Spec.ForProvider),
+			map[string]interface{}{"id": id}, nil)
+	} else {
+		details, err = e.send(ctx, http.MethodGet, withCursor(cr.Spec.ForProvider, orderURL(endpoint, id), cursor), "", nil)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errObserve)
+	}
+
+	cr.Status.AtProvider.LastResponseStatus = details.HttpResponse.StatusCode
+
+	if cursor != "" {
+		switch details.HttpResponse.StatusCode {
+		case http.StatusBadRequest, http.StatusGone:
+			// The backend no longer accepts the cursor, so the order is
+			// read in full instead.
+			cr.Status.AtProvider.ObserveCursor = ""
+			return e.getOrder(ctx, cr, endpoint, id)
+		case http.StatusNotModified:
+			return observedOrder(cr), nil
+		}
+	}
+
+	switch statusAction(cr.Spec.ForProvider, false, details.HttpResponse.StatusCode) {
+	case v1alpha1.StatusCodeActionSuccess:
+	case v1alpha1.StatusCodeActionNotExists:
+		return nil, nil
+	case v1alpha1.StatusCodeActionTerminal:
+		return nil, &terminalStatusError{code: details.HttpResponse.StatusCode, body: details.HttpResponse.Body}
+	default:
+		return nil, errors.Errorf("unexpected status code: %d, body: %s",
+			details.HttpResponse.StatusCode, details.HttpResponse.Body)
+	}
+
+	var order *OrderResponse
+	if cursor != "" {
+		order, err = mergeOrder(cr, details.HttpResponse.Body)
+	} else {
+		order, err = parseOrder(cr.Spec.ForProvider, details.HttpResponse.Body)
+	}
+	if err != nil || order == nil {
+		return order, err
+	}
+	if cursor == "" && readsDeltas(cr) {
+		cr.Status.AtProvider.ObserveCursor = order.Cursor
+	}
+	if order.Version == "" {
+		order.Version = headerValue(details.HttpResponse.Headers, "ETag")
+	}
+	return order, nil
+}
+
+// confirmOrder reads the supplied orders back from the backend, failing if it
+// does not find any of them.
+func (e *external) confirmOrder(ctx context.Context, cr *v1alpha1.PortOrder, ids []string) error {
+	endpoint := observeEndpoint(cr.Spec.ForProvider)
+	if err := validateEndpoint(endpoint); err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		order, err := e.getOrder(ctx, cr, endpoint, id)
+		if err != nil {
+			return err
+		}
+		if order == nil {
+			return errors.Errorf(errNotFound, id)
+		}
+	}
+	return nil
+}
+
+// notFound returns the observation of an order the backend does not find.
+// Within the grace period after the order was submitted it is still reported
+// as existing, so that it is not submitted again while the backend catches up.
+func (e *external) notFound(cr *v1alpha1.PortOrder, now time.Time) managed.ExternalObservation {
+	grace := defaultNotFoundGracePeriod
+	if cr.Spec.ForProvider.NotFoundGracePeriod != nil {
+		grace = cr.Spec.ForProvider.NotFoundGracePeriod.Duration
+	}
+
+	if t := cr.Status.AtProvider.LastRequestTime; t != nil && now.Before(t.Add(grace)) {
+		e.logger.Debug("PortOrder is not visible yet, assuming it exists", "name", cr.GetName())
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: false,
+	}
+}
+
+// readyToCreate reports whether the order can be submitted now. If it cannot,
+// the reason is recorded as a condition of the order.
+func (e *external) readyToCreate(ctx context.Context, cr *v1alpha1.PortOrder) (bool, error) {
+	if len(cr.Spec.ForProvider.DependsOn) > 0 {
+		pending, err := pendingDependencies(ctx, e.kube, cr)
+		if err != nil {
+			return false, err
+		}
+		if len(pending) > 0 {
+			cr.SetConditions(v1alpha1.WaitingForDependencies(pending))
+			return false, nil
+		}
+	}
+
+	if m := cr.Spec.ForProvider.Maintenance; m != nil {
+		active, err := e.maintenanceActive(ctx, *m)
+		if err != nil {
+			return false, err
+		}
+		if active {
+			cr.SetConditions(v1alpha1.WaitingForMaintenanceWindow())
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1alpha1.PortOrder)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotPortOrder)
 	}
+	defer e.recordRedirect(cr)
+	defer e.recordDeprecation(cr)
+
+	if paused(cr) {
+		return managed.ExternalCreation{}, nil
+	}
+
+	// A create repeated shortly after the order was created returns the
+	// order created before, unless the spec changed since.
+	if c, ok := e.creates.get(cr, time.Now()); ok {
+		e.logger.Debug("Suppressing repeated PortOrder create", "name", cr.GetName(), "orderID", c.observation.OrderID)
+		readyAt := cr.Status.AtProvider.ReadyAt
+		cr.Status.AtProvider = c.observation
+		if readyAt != nil {
+			cr.Status.AtProvider.ReadyAt = readyAt
+		}
+		meta.SetExternalName(cr, c.externalName)
+		return managed.ExternalCreation{}, nil
+	}
 
 	e.logger.Debug("Creating PortOrder", "name", cr.GetName())
 
+	endpoint := createEndpoint(cr.Spec.ForProvider)
+	if err := validateEndpoint(endpoint); err != nil {
+		return managed.ExternalCreation{}, notSubmitted(cr, err)
+	}
+
+	if cr.Spec.ForProvider.Queue != nil && isGraphQL(cr.Spec.ForProvider) {
+		return managed.ExternalCreation{}, errors.New(errQueueGraphQL)
+	}
+
+	direction, err := orderDirection(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	environment, err := orderEnvironment(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	source, destination := e.orderEndpoints(cr)
+
+	if errs := cr.Spec.ForProvider.ValidatePorts(); len(errs) > 0 {
+		return managed.ExternalCreation{}, errs.ToAggregate()
+	}
+	if err := e.checkReservedPorts(cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	ports, err := e.convertPorts(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
 	// Build the request body in the format the API expects
 	orderReq := OrderRequest{
 		Order: OrderPayload{
-			Source:      cr.Spec.ForProvider.Source,
-			Destination: cr.Spec.ForProvider.Destination,
-			Ports:       e.convertPorts(cr.Spec.ForProvider.Ports),
+			Source:      source,
+			Destination: destination,
+			Ports:       ports,
+			Direction:   direction,
+			Environment: environment,
+			Tags:        orderTags(cr),
+			GroupID:     cr.Spec.ForProvider.GroupID,
+
+			RolloutPercentage: cr.Spec.ForProvider.RolloutPercentage,
 		},
 	}
 
-	// Marshal the request body
-	body, err := json.Marshal(orderReq)
-	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errMarshal)
+	if orderReq.Fields, err = bodyFields(cr); err != nil {
+		return managed.ExternalCreation{}, err
 	}
 
-	// Prepare headers
-	headers := make(map[string]string)
-	for k, v := range e.defaultHeaders {
-		headers[k] = v
+	headers := map[string][]string{
+		"X-Request-ID": {fmt.Sprintf("crossplane-%s", cr.GetUID())},
 	}
-	headers["X-Request-ID"] = fmt.Sprintf("crossplane-%s", cr.GetUID())
 
-	// Create the HTTP request using the client's SendRequest method
-	bodyData := httpclient.Data{Encrypted: nil, Decrypted: string(body)}
-	headersData := httpclient.Data{Encrypted: nil, Decrypted: headers}
+	if g := cr.Spec.ForProvider.GroupID; g != "" {
+		headers[groupIDHeader] = []string{g}
+	}
 
-	// Execute the request
-	details, err := e.client.SendRequest(
-		ctx,
-		"POST",
-		cr.Spec.ForProvider.APIEndpoint,
-		bodyData,
-		headersData,
-		false, // InsecureSkipTLSVerify
-	)
+	if ref := cr.Spec.ForProvider.JustificationSecretRef; ref != nil {
+		if orderReq.Order.Justification, err = secretValue(ctx, e.kube, *ref, errGetJustification, errJustificationNoKey); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
+	if tenant := orderTenant(cr); tenant != "" {
+		if h := cr.Spec.ForProvider.TenantHeader; h != "" {
+			headers[h] = []string{tenant}
+		} else {
+			orderReq.Order.Tenant = tenant
+		}
+	}
+
+	body, err := marshalOrder(cr.Spec.ForProvider, orderReq)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errMarshal)
+	}
+	// The backend rejects larger bodies, so they are not sent at all until the
+	// spec changes.
+	if limit := maxRequestBytes(cr.Spec.ForProvider); int64(len(body)) > limit {
+		return managed.ExternalCreation{}, notSubmitted(cr, errors.Errorf(errRequestTooLarge, len(body), limit))
+	}
+	if err := e.validateRequest(ctx, cr, body); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if q := cr.Spec.ForProvider.Quota; q != nil {
+		if err := e.checkQuota(ctx, cr, *q); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
 
+	// Execute the request, submitting it again while the backend asks for it
+	details, err := e.retryOnBodyMatch(ctx, cr.Spec.ForProvider, func() (httpclient.HttpDetails, error) {
+		return e.escalateTimeout(ctx, cr.Spec.ForProvider.TimeoutEscalation, func(ctx context.Context) (httpclient.HttpDetails, error) {
+			if isGraphQL(cr.Spec.ForProvider) {
+				return e.sendGraphQL(ctx, endpoint, createMutation(cr.Spec.ForProvider),
+					map[string]interface{}{"order": orderReq.Order}, headers)
+			}
+			return e.send(ctx, http.MethodPost, endpoint, string(body), headers)
+		})
+	})
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, "failed to create order")
 	}
+	if cr.Spec.ForProvider.StreamingResponse {
+		if details.HttpResponse.Body, err = e.readProgressStream(cr, details.HttpResponse.Body); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
 
 	// Update status
 	now := metav1.Now()
 	cr.Status.AtProvider.LastRequestTime = &now
 	cr.Status.AtProvider.LastResponseStatus = details.HttpResponse.StatusCode
 
-	// Check if request was successful
-	if details.HttpResponse.StatusCode != 201 && details.HttpResponse.StatusCode != 200 {
+	// Check if request was successful. An order rejected with a terminal
+	// status code is not submitted again until its spec changes.
+	switch code := details.HttpResponse.StatusCode; statusAction(cr.Spec.ForProvider, true, code) {
+	case v1alpha1.StatusCodeActionSuccess:
+	case v1alpha1.StatusCodeActionTerminal:
+		cr.Status.AtProvider.StatusReason = e.redactor.redact(responseReason(details.HttpResponse.Body))
+		cr.Status.AtProvider.RejectedGeneration = cr.GetGeneration()
+		cr.Status.AtProvider.QueueState = ""
+		cr.SetConditions(v1alpha1.OrderRejected(code))
+		return managed.ExternalCreation{}, &terminalStatusError{code: code, body: details.HttpResponse.Body}
+	default:
+		cr.Status.AtProvider.StatusReason = e.redactor.redact(responseReason(details.HttpResponse.Body))
 		return managed.ExternalCreation{}, errors.Errorf("unexpected status code: %d, body: %s",
-			details.HttpResponse.StatusCode, string(details.HttpResponse.Body))
+			code, details.HttpResponse.Body)
+	}
+
+	// Some backends report failures in the body of a successful response
+	if err := checkSuccessField(cr.Spec.ForProvider, details.HttpResponse.Body); err != nil {
+		return managed.ExternalCreation{}, err
 	}
 
-	// Parse response to get order ID
-	var orderResp OrderResponse
-	if err := json.Unmarshal(details.HttpResponse.Body, &orderResp); err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errUnmarshal)
+	// A queued order is only identified once its job completes.
+	if q := cr.Spec.ForProvider.Queue; q != nil {
+		if err := recordTicket(cr, *q, details.HttpResponse.Body); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		e.creates.set(cr, time.Now())
+		return managed.ExternalCreation{}, nil
+	}
+
+	// Parse response to get order ID. Some backends respond with an empty
+	// body and identify the order in a header instead.
+	var orderResp *OrderResponse
+	if !isGraphQL(cr.Spec.ForProvider) && strings.TrimSpace(details.HttpResponse.Body) == "" {
+		orderResp, err = orderFromHeaders(cr.Spec.ForProvider, details.HttpResponse.Headers)
+	} else {
+		orderResp, err = parseOrder(cr.Spec.ForProvider, details.HttpResponse.Body)
+	}
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if orderResp == nil {
+		return managed.ExternalCreation{}, errors.New(errNoOrder)
+	}
+
+	// The order is only recorded once the backend confirms it, so that an
+	// unconfirmed order is submitted again.
+	if cr.Spec.ForProvider.ConfirmCreate {
+		if err := e.confirmOrder(ctx, cr, orderResp.OrderID); err != nil {
+			return managed.ExternalCreation{}, err
+		}
 	}
 
 	// Update status with order details
-	cr.Status.AtProvider.OrderID = orderResp.OrderID
+	cr.Status.AtProvider.OrderID = orderResp.OrderID.first()
 	cr.Status.AtProvider.Status = orderResp.Status
+	cr.Status.AtProvider.StatusReason = e.redactor.redact(orderResp.Reason)
+	e.recordReceipt(cr, orderResp.Receipt)
+	cr.Status.AtProvider.OrderIDs = nil
+	if len(orderResp.OrderID) > 1 {
+		cr.Status.AtProvider.OrderIDs = orderResp.OrderID
+	}
+
+	// Orders that are not observed again are compared against what was
+	// submitted.
+	if createOnly(cr.Spec.ForProvider) {
+		cr.Status.AtProvider.Source, cr.Status.AtProvider.Destination = source, destination
+	}
 
 	// Set external name to order ID
-	meta.SetExternalName(cr, orderResp.OrderID)
+	meta.SetExternalName(cr, orderResp.OrderID.first())
+	e.orders.invalidate(orderIDs(cr)...)
+
+	if p := cr.Spec.ForProvider.RolloutPercentage; p != nil {
+		cr.Status.AtProvider.RolloutPercentage = p
+		cr.Status.AtProvider.LastRolloutStepTime = &now
+	}
 
+	e.creates.set(cr, time.Now())
+	e.publishEvent(ctx, cr, orderEventCreated)
 	return managed.ExternalCreation{}, nil
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	// Port orders are typically immutable once created
-	// In a real implementation, you might support status updates or modifications
+	cr, ok := mg.(*v1alpha1.PortOrder)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPortOrder)
+	}
+	defer e.recordRedirect(cr)
+	defer e.recordDeprecation(cr)
+
+	if paused(cr) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	// Port orders are typically immutable once created, apart from their
+	// source and destination, their ports if changed at the backend, and the
+	// progress of their rollout.
+	if endpointsDrifted(cr) {
+		e.logger.Debug("Amending PortOrder source and destination", "name", cr.GetName())
+		defer e.orders.invalidate(orderIDs(cr)...)
+		return managed.ExternalUpdate{}, e.amendEndpoints(ctx, cr)
+	}
+	if reassertPorts(cr) {
+		e.logger.Debug("Reasserting PortOrder ports changed at the backend", "name", cr.GetName())
+		defer e.orders.invalidate(orderIDs(cr)...)
+		return managed.ExternalUpdate{}, e.amendPorts(ctx, cr)
+	}
+	if next, ok := nextRolloutPercentage(cr, time.Now()); ok {
+		e.logger.Debug("Raising PortOrder rollout percentage", "name", cr.GetName(), "percentage", next)
+		defer e.orders.invalidate(orderIDs(cr)...)
+		return managed.ExternalUpdate{}, e.stepRollout(ctx, cr, next)
+	}
 	return managed.ExternalUpdate{}, nil
 }
 
@@ -279,22 +1262,248 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	if !ok {
 		return errors.New(errNotPortOrder)
 	}
+	defer e.recordRedirect(cr)
+	defer e.recordDeprecation(cr)
+
+	if paused(cr) {
+		return nil
+	}
 
 	e.logger.Debug("Deleting PortOrder", "name", cr.GetName())
 
-	// In a real implementation, you might send a DELETE request to cancel the order
-	// For now, we'll just consider it deleted
+	if e.streams != nil {
+		e.streams.Stop(cr.GetUID())
+	}
+	e.schemas.forget(cr.GetUID())
+	e.creates.forget(cr)
+
+	// The order is cancelled once. Whether the backend removed it is checked
+	// by Observe.
+	if cr.Status.AtProvider.OrderID == "" || cr.Status.AtProvider.CancelRequestTime != nil {
+		return nil
+	}
+	defer e.orders.invalidate(orderIDs(cr)...)
+	if err := e.cancel(ctx, cr); err != nil {
+		return err
+	}
+	e.publishEvent(ctx, cr, orderEventDeleted)
 	return nil
 }
 
-// convertPorts converts from our CRD format to the API format
-func (e *external) convertPorts(ports []v1alpha1.PortParameters) []PortEntry {
-	result := make([]PortEntry, len(ports))
-	for i, p := range ports {
-		result[i] = PortEntry{
-			Protocol: strings.ToUpper(p.Type),
-			Port:     p.Number,
+// send issues a request against the orders API. Requests with methods that
+// change orders hold a write slot while they are sent.
+func (e *external) send(ctx context.Context, method, url, body string, headers map[string][]string) (httpclient.HttpDetails, error) {
+	return e.sendRequest(ctx, isWriteMethod(method), method, url, body, headers)
+}
+
+// sendRequest issues a request against the orders API with the default
+// headers and authentication applied, holding a write slot while it is sent
+// if write is set. When OAuth2 is in use, a 401 response refreshes the token
+// and the request is retried once. Likewise, a 401 or 403 response refreshes
+// the signed URL of the request when signed URLs are in use.
+func (e *external) sendRequest(ctx context.Context, write bool, method, url, body string, headers map[string][]string) (httpclient.HttpDetails, error) {
+	if write {
+		release, err := e.writes.acquire(ctx)
+		if err != nil {
+			return httpclient.HttpDetails{}, errors.Wrap(err, errWriteSlot)
+		}
+		defer release()
+	}
+
+	details, err := e.sendToEndpoints(ctx, write, method, url, body, headers, false)
+	if err != nil || !e.canRefresh(details.HttpResponse) {
+		return details, err
+	}
+
+	e.logger.Debug("Orders API rejected the request, refreshing credentials", "url", url, "statusCode", details.HttpResponse.StatusCode)
+	return e.sendToEndpoints(ctx, write, method, url, body, headers, true)
+}
+
+// canRefresh reports whether a request that got the supplied response may
+// succeed with refreshed credentials.
+func (e *external) canRefresh(resp httpclient.HttpResponse) bool {
+	switch {
+	case e.tokens != nil && resp.StatusCode == http.StatusUnauthorized:
+		return true
+	case e.signer != nil && (resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden):
+		return true
+	case e.hmac != nil && e.hmac.signatureRejected(resp):
+		return true
+	default:
+		return false
+	}
+}
+
+// preparedRequest is a request to the orders API with its headers,
+// credentials and signatures applied.
+type preparedRequest struct {
+	url  string
+	body string
+	// headers are logged, while sensitive are sent.
+	headers, sensitive map[string][]string
+}
+
+// prepareRequest applies the default headers, credentials, encryption and
+// signatures of the order to a request.
+func (e *external) prepareRequest(ctx context.Context, method, url, body string, headers map[string][]string, refresh bool) (preparedRequest, error) {
+	h := make(map[string][]string, len(e.defaultHeaders)+len(headers))
+	for k, v := range e.defaultHeaders {
+		h[k] = []string{v}
+	}
+	for k, v := range headers {
+		h[k] = v
+	}
+	if e.tenantID != "" {
+		h[tenantIDHeader] = []string{e.tenantID}
+	}
+
+	// Propagate the trace context of the operation to the backend.
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(h))
+
+	// Encrypted bodies are sent and logged as their JWE only. Their
+	// content type is set before the headers that are sent are copied.
+	if e.envelope != nil && body != "" {
+		encrypted, err := e.envelope.encrypt(body)
+		if err != nil {
+			return preparedRequest{}, err
+		}
+		body = encrypted
+		h["Content-Type"] = []string{jweContentType}
+	}
+
+	// The token is only added to the headers that are sent, never to the
+	// ones that are logged.
+	sensitive := h
+	if e.tokens != nil {
+		token, err := e.tokens.Token(ctx, refresh)
+		if err != nil {
+			return preparedRequest{}, errors.Wrap(err, errGetToken)
+		}
+		sensitive = make(map[string][]string, len(h)+1)
+		for k, v := range h {
+			sensitive[k] = v
 		}
+		sensitive[authKey] = []string{"Bearer " + token}
 	}
-	return result
-}
\ No newline at end of file
+
+	// Refreshed HMAC credentials are the secondary signing key. The body is
+	// signed and sent in canonical form so its signature is reproducible,
+	// unless it is encrypted.
+	if e.hmac != nil {
+		if e.envelope == nil {
+			canonical, err := canonicalJSON(body)
+			if err != nil {
+				return preparedRequest{}, err
+			}
+			body = canonical
+		}
+		signature := e.hmac.Sign(method, url, body, time.Now(), refresh)
+		sensitive = make(map[string][]string, len(h)+len(signature))
+		for k, v := range h {
+			sensitive[k] = v
+		}
+		for k, v := range signature {
+			sensitive[k] = v
+		}
+	}
+
+	// The request is sent to its signed URL, if signed URLs are in use.
+	if e.signer != nil {
+		signed, err := e.signer.Sign(ctx, method, url, refresh)
+		if err != nil {
+			return preparedRequest{}, errors.Wrap(err, errSignURL)
+		}
+		url = signed
+	}
+
+	return preparedRequest{url: url, body: body, headers: h, sensitive: sensitive}, nil
+}
+
+func (e *external) sendOnce(ctx context.Context, method, url, body string, headers map[string][]string, refresh bool) (httpclient.HttpDetails, error) {
+	p, err := e.prepareRequest(ctx, method, url, body, headers, refresh)
+	if err != nil {
+		return httpclient.HttpDetails{}, err
+	}
+	url = p.url
+
+	start := time.Now()
+	details, err := e.client.SendRequest(ctx, method, url,
+		httpclient.Data{Encrypted: p.body, Decrypted: p.body},
+		httpclient.Data{Encrypted: p.headers, Decrypted: p.sensitive},
+		false, // InsecureSkipTLSVerify
+	)
+	e.latencies.observe(url, time.Since(start))
+	if err != nil {
+		return details, err
+	}
+
+	if e.envelope != nil && encryptedResponse(details.HttpResponse) {
+		if details.HttpResponse.Body, err = e.envelope.decrypt(details.HttpResponse.Body); err != nil {
+			return details, err
+		}
+	}
+
+	e.responded = true
+	if d, ok := responseDeprecation(url, details.HttpResponse); ok {
+		e.deprecation = d
+	}
+
+	if loc, ok := redirectLocation(details.HttpResponse); ok {
+		return details, errors.Errorf(errRedirected, withoutQuery(url), withoutQuery(loc))
+	}
+	if u := details.HttpResponse.RedirectedURL; u != "" {
+		e.logger.Debug("Orders API redirected the request", "url", withoutQuery(url), "redirectedURL", withoutQuery(u))
+		e.redirectedURL = withoutQuery(u)
+	}
+	return details, nil
+}
+
+// syncStream starts or stops the status stream of the order according to its
+// WatchViaWebSocket and StreamStatus settings.
+func (e *external) syncStream(cr *v1alpha1.PortOrder, endpoint string) error {
+	if e.streams == nil {
+		return nil
+	}
+	if !cr.Spec.ForProvider.WatchViaWebSocket && !cr.Spec.ForProvider.StreamStatus {
+		e.streams.Stop(cr.GetUID())
+		return nil
+	}
+
+	streamer, ok := e.client.(httpclient.Streamer)
+	if !ok {
+		return errors.New(errNoStreamer)
+	}
+	if cr.Spec.ForProvider.WatchViaWebSocket {
+		wsEndpoint, err := webSocketEndpoint(cr.Spec.ForProvider)
+		if err != nil {
+			return err
+		}
+		e.streams.Ensure(cr, streamRequest{
+			url:       wsEndpoint,
+			prepare:   e.streamPreparer(nil),
+			client:    streamer,
+			websocket: true,
+			orderID:   cr.Status.AtProvider.OrderID,
+		})
+		return nil
+	}
+	e.streams.Ensure(cr, streamRequest{
+		url:     orderURL(endpoint, cr.Status.AtProvider.OrderID) + "/stream",
+		prepare: e.streamPreparer(map[string][]string{"Accept": {"text/event-stream"}}),
+		client:  streamer,
+		orderID: cr.Status.AtProvider.OrderID,
+	})
+	return nil
+}
+
+// streamPreparer returns the function preparing the connection attempts of a
+// status stream like any other request to the orders API.
+func (e *external) streamPreparer(headers map[string][]string) func(ctx context.Context, url string) (string, httpclient.Data, error) {
+	return func(ctx context.Context, url string) (string, httpclient.Data, error) {
+		p, err := e.prepareRequest(ctx, http.MethodGet, url, "", headers, false)
+		if err != nil {
+			return "", httpclient.Data{}, err
+		}
+		return p.url, httpclient.Data{Encrypted: p.headers, Decrypted: p.sensitive}, nil
+	}
+}
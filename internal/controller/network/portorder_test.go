@@ -0,0 +1,1022 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+const (
+	providerName      = "http-test"
+	testPortOrderName = "test-order"
+	testEndpoint      = "https://api.example.com/orders"
+	testOrderID       = "order-123"
+)
+
+type portOrderModifier func(po *v1alpha1.PortOrder)
+
+func portOrder(m ...portOrderModifier) *v1alpha1.PortOrder {
+	po := &v1alpha1.PortOrder{
+		ObjectMeta: v1.ObjectMeta{
+			Name: testPortOrderName,
+			UID:  "1234",
+		},
+		Spec: v1alpha1.PortOrderSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{
+					Name: providerName,
+				},
+			},
+			ForProvider: v1alpha1.PortOrderParameters{
+				Source:      "10.0.0.0/24",
+				Destination: "10.0.1.0/24",
+				Ports: []v1alpha1.PortParameters{
+					{Type: "tcp", Number: 443},
+				},
+				APIEndpoint: testEndpoint,
+			},
+		},
+	}
+
+	for _, f := range m {
+		f(po)
+	}
+
+	return po
+}
+
+func withSuccessField(path, value string) portOrderModifier {
+	return func(po *v1alpha1.PortOrder) {
+		po.Spec.ForProvider.SuccessField = &v1alpha1.ResponseFieldMatch{Path: path, Value: value}
+	}
+}
+
+type MockSendRequestFn func(ctx context.Context, method string, url string, body httpclient.Data, headers httpclient.Data, skipTLSVerify bool) (resp httpclient.HttpDetails, err error)
+
+type MockHttpClient struct {
+	MockSendRequest MockSendRequestFn
+}
+
+func (c *MockHttpClient) SendRequest(ctx context.Context, method string, url string, body httpclient.Data, headers httpclient.Data, skipTLSVerify bool) (resp httpclient.HttpDetails, err error) {
+	return c.MockSendRequest(ctx, method, url, body, headers, skipTLSVerify)
+}
+
+func respondWith(statusCode int, body string) MockSendRequestFn {
+	return func(_ context.Context, _ string, _ string, _ httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+		return httpclient.HttpDetails{
+			HttpResponse: httpclient.HttpResponse{
+				StatusCode: statusCode,
+				Body:       body,
+			},
+		}, nil
+	}
+}
+
+// respondByMethod responds to each HTTP method with its own response.
+func respondByMethod(responses map[string]MockSendRequestFn) MockSendRequestFn {
+	return func(ctx context.Context, method string, url string, body httpclient.Data, headers httpclient.Data, skip bool) (httpclient.HttpDetails, error) {
+		return responses[method](ctx, method, url, body, headers, skip)
+	}
+}
+
+type notPortOrder struct {
+	resource.Managed
+}
+
+func Test_external_Create(t *testing.T) {
+	type args struct {
+		http httpclient.Client
+		mg   resource.Managed
+	}
+	type want struct {
+		err      error
+		orderID  string
+		orderIDs []string
+		reason   string
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotPortOrder": {
+			args: args{
+				mg: notPortOrder{},
+			},
+			want: want{
+				err: errors.New(errNotPortOrder),
+			},
+		},
+		"SendRequestFailed": {
+			args: args{
+				http: &MockHttpClient{
+					MockSendRequest: func(_ context.Context, _ string, _ string, _ httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+						return httpclient.HttpDetails{}, errBoom
+					},
+				},
+				mg: portOrder(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, "failed to create order"),
+			},
+		},
+		"UnexpectedStatusCode": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(500, "oops")},
+				mg:   portOrder(),
+			},
+			want: want{
+				err: errors.New("unexpected status code: 500, body: oops"),
+			},
+		},
+		"Rejected": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(422, `{"reason":"port 22 is not allowed"}`)},
+				mg:   portOrder(),
+			},
+			want: want{
+				err:    errors.New(`unexpected status code: 422, body: {"reason":"port 22 is not allowed"}`),
+				reason: "port 22 is not allowed",
+			},
+		},
+		"ICMPWithPortNumber": {
+			args: args{
+				mg: portOrder(func(po *v1alpha1.PortOrder) {
+					po.Spec.ForProvider.Ports = append(po.Spec.ForProvider.Ports, v1alpha1.PortParameters{Type: "icmp", Number: 8})
+				}),
+			},
+			want: want{
+				err: field.ErrorList{
+					field.Invalid(field.NewPath("spec", "forProvider", "ports").Index(1).Child("number"), 8, "icmp ports must not have a port number"),
+				}.ToAggregate(),
+			},
+		},
+		"PortNumberOutOfRange": {
+			args: args{
+				mg: portOrder(func(po *v1alpha1.PortOrder) {
+					po.Spec.ForProvider.Ports = []v1alpha1.PortParameters{{Type: "tcp", Number: -1}}
+				}),
+			},
+			want: want{
+				err: field.ErrorList{
+					field.Invalid(field.NewPath("spec", "forProvider", "ports").Index(0).Child("number"), -1, "must be between 1 and 65535"),
+				}.ToAggregate(),
+			},
+		},
+		"PortNumberOutOfRangeAllowAnyPort": {
+			args: args{
+				mg: portOrder(func(po *v1alpha1.PortOrder) {
+					po.Spec.ForProvider.AllowAnyPort = true
+					po.Spec.ForProvider.Ports = []v1alpha1.PortParameters{{Type: "tcp", Number: 65536}}
+				}),
+			},
+			want: want{
+				err: field.ErrorList{
+					field.Invalid(field.NewPath("spec", "forProvider", "ports").Index(0).Child("number"), 65536, "must be between 0 and 65535"),
+				}.ToAggregate(),
+			},
+		},
+		"PortNumberMissing": {
+			args: args{
+				mg: portOrder(func(po *v1alpha1.PortOrder) {
+					po.Spec.ForProvider.Ports = []v1alpha1.PortParameters{{Type: "icmp"}, {Type: "udp"}}
+				}),
+			},
+			want: want{
+				err: field.ErrorList{
+					field.Required(field.NewPath("spec", "forProvider", "ports").Index(1).Child("number"), "tcp and udp ports need a port number, unless allowAnyPort is set"),
+				}.ToAggregate(),
+			},
+		},
+		"AnyPortAllowed": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(201, `{"orderId":"order-123","status":"pending"}`)},
+				mg: portOrder(func(po *v1alpha1.PortOrder) {
+					po.Spec.ForProvider.Ports = []v1alpha1.PortParameters{{Type: "udp"}}
+					po.Spec.ForProvider.AllowAnyPort = true
+				}),
+			},
+			want: want{
+				orderID: testOrderID,
+			},
+		},
+		"Success": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(201, `{"orderId":"order-123","status":"pending"}`)},
+				mg:   portOrder(),
+			},
+			want: want{
+				orderID: testOrderID,
+			},
+		},
+		"EmptyBodyWithLocation": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: func(_ context.Context, _ string, _ string, _ httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+					return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{
+						StatusCode: 201,
+						Headers:    map[string][]string{"Location": {testEndpoint + "/order-123"}},
+					}}, nil
+				}},
+				mg: portOrder(),
+			},
+			want: want{
+				orderID: testOrderID,
+			},
+		},
+		"EmptyBodyWithoutOrderID": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(201, "")},
+				mg:   portOrder(),
+			},
+			want: want{
+				err: errors.Errorf(errNoOrderID, "Location"),
+			},
+		},
+		"MultipleOrderIDs": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(201, `{"orderId":["order-123",456],"status":"pending"}`)},
+				mg:   portOrder(),
+			},
+			want: want{
+				orderID:  testOrderID,
+				orderIDs: []string{testOrderID, "456"},
+			},
+		},
+		"CreateConfirmed": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondByMethod(map[string]MockSendRequestFn{
+					http.MethodPost: respondWith(201, `{"orderId":"order-123","status":"pending"}`),
+					http.MethodGet:  respondWith(200, `{"orderId":"order-123","status":"pending"}`),
+				})},
+				mg: portOrder(func(po *v1alpha1.PortOrder) {
+					po.Spec.ForProvider.ConfirmCreate = true
+				}),
+			},
+			want: want{
+				orderID: testOrderID,
+			},
+		},
+		"CreateNotConfirmed": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondByMethod(map[string]MockSendRequestFn{
+					http.MethodPost: respondWith(201, `{"orderId":"order-123","status":"pending"}`),
+					http.MethodGet:  respondWith(404, ""),
+				})},
+				mg: portOrder(func(po *v1alpha1.PortOrder) {
+					po.Spec.ForProvider.ConfirmCreate = true
+				}),
+			},
+			want: want{
+				err: errors.Errorf(errNotFound, testOrderID),
+			},
+		},
+		"SuccessFieldMismatch": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(200, `{"success":false,"message":"quota exceeded"}`)},
+				mg:   portOrder(withSuccessField("success", "true")),
+			},
+			want: want{
+				err: errors.Errorf(errOrderNotAccepted, "quota exceeded"),
+			},
+		},
+		"SuccessFieldMatch": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(200, `{"success":true,"orderId":"order-123"}`)},
+				mg:   portOrder(withSuccessField("success", "true")),
+			},
+			want: want{
+				orderID: testOrderID,
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.args.http,
+				logger: logging.NewNopLogger(),
+			}
+			_, gotErr := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, gotErr, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.Create(...): -want error, +got error: %s", diff)
+			}
+
+			if cr, ok := tc.args.mg.(*v1alpha1.PortOrder); ok {
+				if diff := cmp.Diff(tc.want.orderID, cr.Status.AtProvider.OrderID); diff != "" {
+					t.Fatalf("e.Create(...): -want OrderID, +got OrderID: %s", diff)
+				}
+				if diff := cmp.Diff(tc.want.orderIDs, cr.Status.AtProvider.OrderIDs); diff != "" {
+					t.Fatalf("e.Create(...): -want OrderIDs, +got OrderIDs: %s", diff)
+				}
+				if diff := cmp.Diff(tc.want.reason, cr.Status.AtProvider.StatusReason); diff != "" {
+					t.Fatalf("e.Create(...): -want StatusReason, +got StatusReason: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func Test_maxResponseBytes(t *testing.T) {
+	limit := int64(512)
+
+	cases := map[string]struct {
+		params v1alpha1.PortOrderParameters
+		want   int64
+	}{
+		"Default": {
+			params: v1alpha1.PortOrderParameters{},
+			want:   defaultMaxResponseBytes,
+		},
+		"Configured": {
+			params: v1alpha1.PortOrderParameters{MaxResponseBytes: &limit},
+			want:   limit,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, maxResponseBytes(tc.params)); diff != "" {
+				t.Fatalf("maxResponseBytes(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_send_OAuth2Refresh(t *testing.T) {
+	const tokenURL = "https://auth.example.com/token"
+
+	type want struct {
+		statusCode    int
+		orderCalls    int
+		tokenRequests int
+	}
+
+	cases := map[string]struct {
+		// validToken is the only token the orders API accepts.
+		validToken string
+		want       want
+	}{
+		"RefreshedTokenAccepted": {
+			validToken: "token-2",
+			want: want{
+				statusCode:    201,
+				orderCalls:    2,
+				tokenRequests: 2,
+			},
+		},
+		"RetriesOnlyOnce": {
+			validToken: "never-issued",
+			want: want{
+				statusCode:    401,
+				orderCalls:    2,
+				tokenRequests: 2,
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			h := &MockHttpClient{
+				MockSendRequest: func(_ context.Context, _ string, url string, _ httpclient.Data, headers httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+					if url == tokenURL {
+						got.tokenRequests++
+						return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{
+							StatusCode: 200,
+							Body:       fmt.Sprintf(`{"access_token":"token-%d","expires_in":3600}`, got.tokenRequests),
+						}}, nil
+					}
+					got.orderCalls++
+					if headers.Decrypted.(map[string][]string)[authKey][0] != "Bearer "+tc.validToken {
+						return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 401}}, nil
+					}
+					return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 201}}, nil
+				},
+			}
+
+			e := &external{
+				client: h,
+				logger: logging.NewNopLogger(),
+				tokens: &tokenSource{
+					client: h,
+					cache:  newTokenCache(),
+					config: credentialsConfig{AuthType: authTypeOAuth2, TokenURL: tokenURL, ClientID: "id"},
+				},
+			}
+
+			details, err := e.send(context.Background(), http.MethodPost, testEndpoint, "{}", nil)
+			if err != nil {
+				t.Fatalf("e.send(...): unexpected error: %s", err)
+			}
+			got.statusCode = details.HttpResponse.StatusCode
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Fatalf("e.send(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Observe(t *testing.T) {
+	type args struct {
+		kube client.Client
+		http httpclient.Client
+		mg   resource.Managed
+	}
+	type want struct {
+		obs      managed.ExternalObservation
+		err      error
+		status   string
+		devices  int
+		ruleIDs  []string
+		reason   string
+		modified *v1.Time
+		zone     string
+	}
+
+	withOrderID := func(po *v1alpha1.PortOrder) {
+		po.Status.AtProvider.OrderID = testOrderID
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotPortOrder": {
+			args: args{
+				mg: notPortOrder{},
+			},
+			want: want{
+				err: errors.New(errNotPortOrder),
+			},
+		},
+		"NoOrderID": {
+			args: args{
+				mg: portOrder(),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"WaitingForDependencies": {
+			args: args{
+				kube: &test.MockClient{MockGet: withOrders(namedOrder("dep", "pending"))},
+				mg: portOrder(func(po *v1alpha1.PortOrder) {
+					po.Spec.ForProvider.DependsOn = []string{"dep"}
+				}),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"DependenciesReady": {
+			args: args{
+				kube: &test.MockClient{MockGet: withOrders(namedOrder("dep", "active"))},
+				mg: portOrder(func(po *v1alpha1.PortOrder) {
+					po.Spec.ForProvider.DependsOn = []string{"dep"}
+				}),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"WaitingForMaintenanceWindow": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(200, `{"active":true}`)},
+				mg: portOrder(func(po *v1alpha1.PortOrder) {
+					po.Spec.ForProvider.Maintenance = &v1alpha1.MaintenanceCheck{Endpoint: testMaintenanceEndpoint}
+				}),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"DependencyCycle": {
+			args: args{
+				kube: &test.MockClient{MockGet: withOrders(namedOrder("dep", "", "test-order"))},
+				mg: portOrder(func(po *v1alpha1.PortOrder) {
+					po.Spec.ForProvider.DependsOn = []string{"dep"}
+				}),
+			},
+			want: want{
+				err: errors.Errorf(errDependencyCycle, "test-order -> dep -> test-order"),
+			},
+		},
+		"InvalidEndpoint": {
+			args: args{
+				mg: portOrder(withOrderID, func(po *v1alpha1.PortOrder) {
+					po.Spec.ForProvider.ObserveEndpoint = "not-a-url"
+				}),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotFound": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(404, "")},
+				mg:   portOrder(withOrderID),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"NotFoundWithinGracePeriod": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(404, "")},
+				mg: portOrder(withOrderID, func(po *v1alpha1.PortOrder) {
+					po.Status.AtProvider.LastRequestTime = &v1.Time{Time: time.Now().Add(-10 * time.Second)}
+				}),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotFoundAfterGracePeriod": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(404, "")},
+				mg: portOrder(withOrderID, func(po *v1alpha1.PortOrder) {
+					po.Status.AtProvider.LastRequestTime = &v1.Time{Time: time.Now().Add(-2 * time.Minute)}
+				}),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"NotFoundCustomGracePeriod": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(404, "")},
+				mg: portOrder(withOrderID, func(po *v1alpha1.PortOrder) {
+					po.Spec.ForProvider.NotFoundGracePeriod = &v1.Duration{Duration: 5 * time.Minute}
+					po.Status.AtProvider.LastRequestTime = &v1.Time{Time: time.Now().Add(-2 * time.Minute)}
+				}),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"UnexpectedStatusCode": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(500, "oops")},
+				mg:   portOrder(withOrderID),
+			},
+			want: want{
+				err: errors.New("unexpected status code: 500, body: oops"),
+			},
+		},
+		"Exists": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(200, `{"orderId":"order-123","status":"active"}`)},
+				mg:   portOrder(withOrderID),
+			},
+			want: want{
+				obs:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				status: "active",
+			},
+		},
+		"AffectedDevices": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(200, `{"orderId":"order-123","status":"active","affectedDevices":12}`)},
+				mg:   portOrder(withOrderID),
+			},
+			want: want{
+				obs:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				status:  "active",
+				devices: 12,
+			},
+		},
+		"RuleIDs": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(200, `{"orderId":"order-123","status":"active","ruleIds":["fw1-77",78]}`)},
+				mg:   portOrder(withOrderID),
+			},
+			want: want{
+				obs:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				status:  "active",
+				ruleIDs: []string{"fw1-77", "78"},
+			},
+		},
+		"RuleIDsNotReported": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(200, `{"orderId":"order-123","status":"active"}`)},
+				mg: portOrder(withOrderID, func(po *v1alpha1.PortOrder) {
+					po.Status.AtProvider.RuleIDs = []string{"fw1-77"}
+				}),
+			},
+			want: want{
+				obs:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				status:  "active",
+				ruleIDs: []string{"fw1-77"},
+			},
+		},
+		"Rejected": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(200, `{"orderId":"order-123","status":"rejected","reason":"destination is not routable"}`)},
+				mg:   portOrder(withOrderID),
+			},
+			want: want{
+				obs:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				status: "rejected",
+				reason: "destination is not routable",
+			},
+		},
+		"LastModified": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(200, `{"orderId":"order-123","status":"active","lastModified":"2025-03-01T10:00:00Z"}`)},
+				mg:   portOrder(withOrderID),
+			},
+			want: want{
+				obs:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				status:   "active",
+				modified: &v1.Time{Time: time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)},
+			},
+		},
+		"LastModifiedMalformed": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(200, `{"orderId":"order-123","status":"active","lastModified":"last tuesday"}`)},
+				mg: portOrder(withOrderID, func(po *v1alpha1.PortOrder) {
+					po.Status.AtProvider.LastModifiedByBackend = &v1.Time{Time: time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)}
+				}),
+			},
+			want: want{
+				obs:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				status: "active",
+			},
+		},
+		"Zone": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(200, `{"orderId":"order-123","status":"active","zone":"dmz-east"}`)},
+				mg:   portOrder(withOrderID),
+			},
+			want: want{
+				obs:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				status: "active",
+				zone:   "dmz-east",
+			},
+		},
+		"ZoneNotReported": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(200, `{"orderId":"order-123","status":"active"}`)},
+				mg: portOrder(withOrderID, func(po *v1alpha1.PortOrder) {
+					po.Status.AtProvider.Zone = "dmz-east"
+				}),
+			},
+			want: want{
+				obs:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				status: "active",
+				zone:   "dmz-east",
+			},
+		},
+		"ReasonNotReported": {
+			args: args{
+				http: &MockHttpClient{MockSendRequest: respondWith(200, `{"orderId":"order-123","status":"active"}`)},
+				mg: portOrder(withOrderID, func(po *v1alpha1.PortOrder) {
+					po.Status.AtProvider.StatusReason = "awaiting approval"
+				}),
+			},
+			want: want{
+				obs:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				status: "active",
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				kube:   tc.args.kube,
+				client: tc.args.http,
+				logger: logging.NewNopLogger(),
+			}
+			got, gotErr := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, gotErr, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.Observe(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Fatalf("e.Observe(...): -want, +got: %s", diff)
+			}
+			if cr, ok := tc.args.mg.(*v1alpha1.PortOrder); ok && gotErr == nil {
+				if diff := cmp.Diff(tc.want.status, cr.Status.AtProvider.Status); diff != "" {
+					t.Fatalf("e.Observe(...): -want Status, +got Status: %s", diff)
+				}
+				if diff := cmp.Diff(tc.want.devices, cr.Status.AtProvider.AffectedDevices); diff != "" {
+					t.Fatalf("e.Observe(...): -want AffectedDevices, +got AffectedDevices: %s", diff)
+				}
+				if diff := cmp.Diff(tc.want.ruleIDs, cr.Status.AtProvider.RuleIDs); diff != "" {
+					t.Fatalf("e.Observe(...): -want RuleIDs, +got RuleIDs: %s", diff)
+				}
+				if diff := cmp.Diff(tc.want.reason, cr.Status.AtProvider.StatusReason); diff != "" {
+					t.Fatalf("e.Observe(...): -want StatusReason, +got StatusReason: %s", diff)
+				}
+				if diff := cmp.Diff(tc.want.modified, cr.Status.AtProvider.LastModifiedByBackend); diff != "" {
+					t.Fatalf("e.Observe(...): -want LastModifiedByBackend, +got LastModifiedByBackend: %s", diff)
+				}
+				if diff := cmp.Diff(tc.want.zone, cr.Status.AtProvider.Zone); diff != "" {
+					t.Fatalf("e.Observe(...): -want Zone, +got Zone: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func Test_external_CreateOnly(t *testing.T) {
+	requests := map[string]int{}
+	e := &external{
+		client: &MockHttpClient{MockSendRequest: func(_ context.Context, method string, _ string, _ httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+			requests[method]++
+			if method != http.MethodPost {
+				return httpclient.HttpDetails{}, errBoom
+			}
+			return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 201, Body: `{"orderId":"order-123","status":"pending"}`}}, nil
+		}},
+		logger: logging.NewNopLogger(),
+	}
+	cr := portOrder(func(po *v1alpha1.PortOrder) {
+		po.Spec.ForProvider.ObserveMode = v1alpha1.ObserveModeCreateOnly
+	})
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("e.Create(...): %v", err)
+	}
+
+	// The order is not read from the backend once created.
+	got, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, got); diff != "" {
+		t.Fatalf("e.Observe(...): -want, +got: %s", diff)
+	}
+	if diff := cmp.Diff(xpv1.Available(), cr.GetCondition(xpv1.TypeReady), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+		t.Fatalf("e.Observe(...): -want Ready condition, +got Ready condition: %s", diff)
+	}
+
+	// A changed destination still needs an update.
+	cr.Spec.ForProvider.Destination = "10.0.2.0/24"
+	got, err = e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, got); diff != "" {
+		t.Fatalf("e.Observe(...) after changing the destination: -want, +got: %s", diff)
+	}
+
+	if diff := cmp.Diff(map[string]int{http.MethodPost: 1}, requests); diff != "" {
+		t.Fatalf("requests by method: -want, +got: %s", diff)
+	}
+}
+
+func Test_external_Endpoints(t *testing.T) {
+	const (
+		writeEndpoint = "https://write.example.com/orders"
+		readEndpoint  = "https://read.example.com/orders/"
+	)
+
+	var got []string
+	e := &external{
+		client: &MockHttpClient{
+			MockSendRequest: func(_ context.Context, method string, url string, _ httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+				got = append(got, method+" "+url)
+				return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{
+					StatusCode: 200,
+					Body:       `{"orderId":"order-123","status":"pending"}`,
+				}}, nil
+			},
+		},
+		logger: logging.NewNopLogger(),
+	}
+
+	cr := portOrder(func(po *v1alpha1.PortOrder) {
+		po.Spec.ForProvider.CreateEndpoint = writeEndpoint
+		po.Spec.ForProvider.ObserveEndpoint = readEndpoint
+	})
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("e.Create(...): unexpected error: %s", err)
+	}
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("e.Observe(...): unexpected error: %s", err)
+	}
+
+	want := []string{
+		"POST " + writeEndpoint,
+		"GET https://read.example.com/orders/order-123",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("requests: -want, +got: %s", diff)
+	}
+}
+
+func Test_external_Create_Tenant(t *testing.T) {
+	type want struct {
+		tenant string
+		header []string
+	}
+
+	cases := map[string]struct {
+		header string
+		want   want
+	}{
+		"BodyField": {
+			want: want{tenant: "team-a"},
+		},
+		"Header": {
+			header: "X-Tenant",
+			want:   want{header: []string{"team-a"}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			e := &external{
+				client: &MockHttpClient{
+					MockSendRequest: func(_ context.Context, _ string, _ string, body httpclient.Data, headers httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+						req := OrderRequest{}
+						_ = json.Unmarshal([]byte(body.Decrypted.(string)), &req)
+						got.tenant = req.Order.Tenant
+						got.header = headers.Decrypted.(map[string][]string)["X-Tenant"]
+						return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 201, Body: `{"orderId":"order-123"}`}}, nil
+					},
+				},
+				logger: logging.NewNopLogger(),
+			}
+
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.SetAnnotations(map[string]string{defaultTenantAnnotation: "team-a"})
+				po.Spec.ForProvider.TenantHeader = tc.header
+			})
+			if _, err := e.Create(context.Background(), cr); err != nil {
+				t.Fatalf("e.Create(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Fatalf("e.Create(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_validateEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		endpoint string
+		// want is the expected error message, up to any detail added by the
+		// URL parser.
+		want string
+	}{
+		"Valid": {
+			endpoint: "https://api.example.com/orders",
+		},
+		"ValidWithPort": {
+			endpoint: "http://10.0.0.1:8080/orders",
+		},
+		"MissingScheme": {
+			endpoint: "api.example.com/orders",
+			want:     fmt.Sprintf(errEndpointScheme, "api.example.com/orders"),
+		},
+		"UnsupportedScheme": {
+			endpoint: "ftp://api.example.com/orders",
+			want:     fmt.Sprintf(errEndpointScheme, "ftp://api.example.com/orders"),
+		},
+		"MissingHost": {
+			endpoint: "https:///orders",
+			want:     fmt.Sprintf(errEndpointHost, "https:///orders"),
+		},
+		"BadHost": {
+			endpoint: "https://api example.com/orders",
+			want:     fmt.Sprintf(errEndpointInvalid, "https://api example.com/orders"),
+		},
+		"Placeholder": {
+			endpoint: "https://{{.region}}.example.com/orders",
+			want:     fmt.Sprintf(errEndpointPlaceholder, "https://{{.region}}.example.com/orders"),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			err := validateEndpoint(tc.endpoint)
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+			if (tc.want == "") != (got == "") || !strings.HasPrefix(got, tc.want) {
+				t.Fatalf("validateEndpoint(...): want error %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func Test_external_InvalidEndpoint(t *testing.T) {
+	errScheme := errors.Errorf(errEndpointScheme, "not-a-url")
+	withOrderID := func(po *v1alpha1.PortOrder) {
+		po.Status.AtProvider.OrderID = testOrderID
+	}
+
+	type want struct {
+		err       error
+		rejected  int64
+		condition xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		create bool
+		cr     *v1alpha1.PortOrder
+		want   want
+	}{
+		"Observe": {
+			cr: portOrder(withOrderID, func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.ObserveEndpoint = "not-a-url"
+			}),
+			want: want{condition: v1alpha1.InvalidEndpoint(errScheme.Error())},
+		},
+		"ObserveDeleted": {
+			cr: portOrder(withOrderID, func(po *v1alpha1.PortOrder) {
+				now := v1.Now()
+				po.SetDeletionTimestamp(&now)
+				po.Spec.ForProvider.ObserveEndpoint = "not-a-url"
+			}),
+			want: want{
+				err:       errScheme,
+				condition: xpv1.Condition{Type: xpv1.TypeReady, Status: "Unknown"},
+			},
+		},
+		"Create": {
+			create: true,
+			cr: portOrder(withGeneration(3), func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.CreateEndpoint = "not-a-url"
+			}),
+			want: want{
+				err:       errScheme,
+				rejected:  3,
+				condition: v1alpha1.OrderNotSubmitted(errScheme.Error()),
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &MockHttpClient{}, logger: logging.NewNopLogger()}
+
+			call, err := "e.Observe(...)", error(nil)
+			if tc.create {
+				call = "e.Create(...)"
+				_, err = e.Create(context.Background(), tc.cr)
+			} else {
+				_, err = e.Observe(context.Background(), tc.cr)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("%s: -want error, +got error: %s", call, diff)
+			}
+			if diff := cmp.Diff(tc.want.rejected, tc.cr.Status.AtProvider.RejectedGeneration); diff != "" {
+				t.Errorf("%s: -want RejectedGeneration, +got RejectedGeneration: %s", call, diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, tc.cr.GetCondition(xpv1.TypeReady), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("%s: -want Ready condition, +got Ready condition: %s", call, diff)
+			}
+		})
+	}
+}
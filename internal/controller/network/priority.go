@@ -0,0 +1,200 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+// priorityQueue orders the reconciles of PortOrders by their priority. It
+// tracks the priority of every PortOrder from its watch events, which arrive
+// before the PortOrder is queued, and whether it is pending, i.e. being
+// created at the backend. A PortOrder whose reconcile is
+// dequeued while a PortOrder of a higher priority is pending yields to it:
+// it is requeued after delay rather than reconciled, leaving the worker to
+// the pending PortOrder. It yields at most maxDeferrals times in a row, so
+// that PortOrders of a lower priority are slowed down rather than starved
+// by pending PortOrders that keep failing. A nil priorityQueue defers
+// nothing.
+type priorityQueue struct {
+	delay        time.Duration
+	maxDeferrals int
+
+	mu     sync.Mutex
+	orders map[types.NamespacedName]trackedOrder
+	// pending counts the pending PortOrders per priority.
+	pending   map[int32]int
+	deferrals map[types.NamespacedName]int
+}
+
+type trackedOrder struct {
+	priority int32
+	pending  bool
+}
+
+// newPriorityQueue returns a priorityQueue deferring PortOrders by delay at
+// most maxDeferrals times in a row, or nil if either is not positive.
+func newPriorityQueue(delay time.Duration, maxDeferrals int) *priorityQueue {
+	if delay <= 0 || maxDeferrals <= 0 {
+		return nil
+	}
+	return &priorityQueue{
+		delay:        delay,
+		maxDeferrals: maxDeferrals,
+		orders:       map[types.NamespacedName]trackedOrder{},
+		pending:      map[int32]int{},
+		deferrals:    map[types.NamespacedName]int{},
+	}
+}
+
+// orderPending reports whether the PortOrder is being created: it has no
+// order at the backend yet and is neither being deleted nor parked, i.e.
+// paused, awaiting approvals, waiting for its dependencies, group or
+// maintenance window, queued at the backend or rejected. Parked PortOrders
+// are not yielded to, since they do not need the workers until they move on.
+func orderPending(cr *v1alpha1.PortOrder) bool {
+	if cr.GetDeletionTimestamp() != nil || cr.Status.AtProvider.OrderID != "" || rejected(cr) {
+		return false
+	}
+	if cr.GetCondition(v1alpha1.TypePaused).Status == corev1.ConditionTrue ||
+		cr.GetCondition(v1alpha1.TypeApproved).Status == corev1.ConditionFalse {
+		return false
+	}
+	switch cr.GetCondition(xpv1.TypeReady).Reason {
+	case v1alpha1.ReasonWaitingForDependencies, v1alpha1.ReasonWaitingForGroup,
+		v1alpha1.ReasonWaitingForMaintenanceWindow, v1alpha1.ReasonQueued:
+		return false
+	}
+	return true
+}
+
+// observe records the priority of the PortOrder and whether it is pending.
+func (q *priorityQueue) observe(o client.Object) {
+	cr, ok := o.(*v1alpha1.PortOrder)
+	if !ok {
+		return
+	}
+	name := types.NamespacedName{Namespace: cr.GetNamespace(), Name: cr.GetName()}
+	t := trackedOrder{priority: cr.Spec.ForProvider.Priority, pending: orderPending(cr)}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.untrack(name)
+	q.orders[name] = t
+	if t.pending {
+		q.pending[t.priority]++
+	}
+}
+
+// forget stops tracking the deleted PortOrder.
+func (q *priorityQueue) forget(o client.Object) {
+	name := types.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.untrack(name)
+	delete(q.deferrals, name)
+}
+
+func (q *priorityQueue) untrack(name types.NamespacedName) {
+	t, ok := q.orders[name]
+	if !ok {
+		return
+	}
+	delete(q.orders, name)
+	if !t.pending {
+		return
+	}
+	if q.pending[t.priority]--; q.pending[t.priority] <= 0 {
+		delete(q.pending, t.priority)
+	}
+}
+
+// yield reports whether the reconcile of the named PortOrder is to be
+// deferred, because a PortOrder of a higher priority is pending and it did
+// not yet yield maxDeferrals times in a row.
+func (q *priorityQueue) yield(name types.NamespacedName) bool {
+	if q == nil {
+		return false
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	t, ok := q.orders[name]
+	if !ok || q.deferrals[name] >= q.maxDeferrals {
+		delete(q.deferrals, name)
+		return false
+	}
+	for p := range q.pending {
+		if p > t.priority {
+			q.deferrals[name]++
+			return true
+		}
+	}
+	delete(q.deferrals, name)
+	return false
+}
+
+// tracker returns the predicate tracking PortOrders from their watch
+// events. It accepts every event, so it has to come before any predicate
+// that filters them.
+func (q *priorityQueue) tracker() predicate.Predicate {
+	if q == nil {
+		return predicate.Funcs{}
+	}
+	return predicate.Funcs{
+		CreateFunc:  func(e event.CreateEvent) bool { q.observe(e.Object); return true },
+		UpdateFunc:  func(e event.UpdateEvent) bool { q.observe(e.ObjectNew); return true },
+		DeleteFunc:  func(e event.DeleteEvent) bool { q.forget(e.Object); return true },
+		GenericFunc: func(e event.GenericEvent) bool { q.observe(e.Object); return true },
+	}
+}
+
+// priorityReconciler defers the reconciles of PortOrders that yield to
+// pending PortOrders of a higher priority.
+type priorityReconciler struct {
+	reconcile.Reconciler
+	queue *priorityQueue
+}
+
+func (r *priorityReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	if r.queue.yield(req.NamespacedName) {
+		return reconcile.Result{RequeueAfter: r.queue.delay}, nil
+	}
+	return r.Reconciler.Reconcile(ctx, req)
+}
+
+// withPriority returns the reconciler deferring to the supplied queue, or
+// the reconciler itself if the queue is nil.
+func withPriority(r reconcile.Reconciler, q *priorityQueue) reconcile.Reconciler {
+	if q == nil {
+		return r
+	}
+	return &priorityReconciler{Reconciler: r, queue: q}
+}
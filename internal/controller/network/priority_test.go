@@ -0,0 +1,243 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+// prioritized returns a PortOrder of the supplied name and priority, which
+// is pending unless it was created and is ready.
+func prioritized(name string, priority int32, ready bool) *v1alpha1.PortOrder {
+	return portOrder(func(po *v1alpha1.PortOrder) {
+		po.SetName(name)
+		po.Spec.ForProvider.Priority = priority
+		if ready {
+			po.Status.AtProvider.OrderID = testOrderID
+			po.SetConditions(xpv1.Available(), xpv1.ReconcileSuccess())
+		}
+	})
+}
+
+func Test_orderPending(t *testing.T) {
+	now := metav1.Now()
+
+	cases := map[string]struct {
+		cr   *v1alpha1.PortOrder
+		want bool
+	}{
+		"New": {
+			cr:   portOrder(),
+			want: true,
+		},
+		"FailingToCreate": {
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.SetConditions(xpv1.Creating(), xpv1.ReconcileError(errBoom))
+			}),
+			want: true,
+		},
+		"Created": {
+			cr: prioritized("ready", 0, true),
+		},
+		"CreatedFailingToSync": {
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.OrderID = testOrderID
+				po.SetConditions(xpv1.Available(), xpv1.ReconcileError(errBoom))
+			}),
+		},
+		"Deleting": {
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.SetDeletionTimestamp(&now)
+			}),
+		},
+		"Rejected": {
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.SetGeneration(2)
+				po.Status.AtProvider.RejectedGeneration = 2
+			}),
+		},
+		"Paused": {
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.SetConditions(v1alpha1.Paused())
+			}),
+		},
+		"AwaitingApprovals": {
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.SetConditions(v1alpha1.ApprovalsPending(1, 2))
+			}),
+		},
+		"WaitingForDependencies": {
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.SetConditions(v1alpha1.WaitingForDependencies([]string{"base"}))
+			}),
+		},
+		"WaitingForGroup": {
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.SetConditions(v1alpha1.WaitingForGroup([]string{"member"}))
+			}),
+		},
+		"Queued": {
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.SetConditions(v1alpha1.Queued("ticket-1", "waiting"))
+			}),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, orderPending(tc.cr)); diff != "" {
+				t.Errorf("orderPending(...): -want pending, +got pending: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_priorityQueue_yield(t *testing.T) {
+	cases := map[string]struct {
+		orders    []*v1alpha1.PortOrder
+		deleted   []*v1alpha1.PortOrder
+		deferrals int
+		want      []bool
+	}{
+		"NoOtherOrders": {
+			orders: []*v1alpha1.PortOrder{prioritized("order", 0, false)},
+			want:   []bool{false},
+		},
+		"HigherPriorityPending": {
+			orders: []*v1alpha1.PortOrder{prioritized("order", 0, false), prioritized("critical", 10, false)},
+			want:   []bool{true, true, false, true},
+		},
+		"HigherPriorityReady": {
+			orders: []*v1alpha1.PortOrder{prioritized("order", 0, false), prioritized("critical", 10, true)},
+			want:   []bool{false},
+		},
+		"HigherPriorityDeleted": {
+			orders:  []*v1alpha1.PortOrder{prioritized("order", 0, false), prioritized("critical", 10, false)},
+			deleted: []*v1alpha1.PortOrder{prioritized("critical", 10, false)},
+			want:    []bool{false},
+		},
+		"SamePriorityPending": {
+			orders: []*v1alpha1.PortOrder{prioritized("order", 5, false), prioritized("other", 5, false)},
+			want:   []bool{false},
+		},
+		"LowerPriorityPending": {
+			orders: []*v1alpha1.PortOrder{prioritized("order", 5, true), prioritized("bulk", 0, false)},
+			want:   []bool{false},
+		},
+		"Untracked": {
+			orders: []*v1alpha1.PortOrder{prioritized("critical", 10, false)},
+			want:   []bool{false},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			q := newPriorityQueue(time.Second, 2)
+			p := q.tracker()
+			for _, o := range tc.orders {
+				p.Create(event.CreateEvent{Object: o})
+			}
+			for _, o := range tc.deleted {
+				p.Delete(event.DeleteEvent{Object: o})
+			}
+
+			n := types.NamespacedName{Name: "order"}
+			got := make([]bool, 0, len(tc.want))
+			for range tc.want {
+				got = append(got, q.yield(n))
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("q.yield(...): -want yields, +got yields: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_priorityQueue_Update(t *testing.T) {
+	q := newPriorityQueue(time.Second, 5)
+	p := q.tracker()
+	n := types.NamespacedName{Name: "order"}
+
+	p.Create(event.CreateEvent{Object: prioritized("order", 0, false)})
+	p.Create(event.CreateEvent{Object: prioritized("critical", 10, false)})
+	if !q.yield(n) {
+		t.Fatalf("q.yield(...): want yield to the pending critical order")
+	}
+
+	// Status updates are filtered out by the event filter, but still tracked.
+	p.Update(event.UpdateEvent{ObjectOld: prioritized("critical", 10, false), ObjectNew: prioritized("critical", 10, true)})
+	if q.yield(n) {
+		t.Errorf("q.yield(...): want no yield once the critical order is ready")
+	}
+
+	p.Update(event.UpdateEvent{ObjectOld: prioritized("order", 0, false), ObjectNew: prioritized("order", 20, false)})
+	p.Update(event.UpdateEvent{ObjectOld: prioritized("critical", 10, true), ObjectNew: prioritized("critical", 10, false)})
+	if q.yield(n) {
+		t.Errorf("q.yield(...): want no yield to an order of a now lower priority")
+	}
+}
+
+func Test_priorityReconciler_Reconcile(t *testing.T) {
+	cases := map[string]struct {
+		orders []*v1alpha1.PortOrder
+		want   reconcile.Result
+	}{
+		"Reconciled": {
+			orders: []*v1alpha1.PortOrder{prioritized("order", 10, false), prioritized("bulk", 0, false)},
+			want:   reconcile.Result{RequeueAfter: time.Minute},
+		},
+		"Deferred": {
+			orders: []*v1alpha1.PortOrder{prioritized("order", 0, false), prioritized("critical", 10, false)},
+			want:   reconcile.Result{RequeueAfter: 2 * time.Second},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			q := newPriorityQueue(2*time.Second, 5)
+			for _, o := range tc.orders {
+				q.tracker().Create(event.CreateEvent{Object: o})
+			}
+			r := withPriority(reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+				return reconcile.Result{RequeueAfter: time.Minute}, nil
+			}), q)
+
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "order"}})
+			if err != nil {
+				t.Fatalf("r.Reconcile(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r.Reconcile(...): -want result, +got result: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"bufio"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+const (
+	errNoStreamResult = "streamed create response does not contain a complete JSON line"
+	errReadStream     = "cannot read streamed create response"
+
+	// maxProgressLines is the number of progress lines of a create that are
+	// recorded.
+	maxProgressLines = 20
+)
+
+// splitProgressStream splits a newline-delimited JSON response into the last
+// complete JSON line, which is the result, and the complete lines before it,
+// which report progress. Blank lines and lines that are not complete JSON,
+// such as a line cut off at the end of the stream, are skipped.
+func splitProgressStream(body string) (string, []string, error) {
+	var lines []string
+	sc := bufio.NewScanner(strings.NewReader(body))
+	// Lines are read into memory anyway, as the body already is.
+	sc.Buffer(nil, len(body)+1)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || !json.Valid([]byte(line)) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := sc.Err(); err != nil {
+		return "", nil, errors.Wrap(err, errReadStream)
+	}
+	if len(lines) == 0 {
+		return "", nil, errors.New(errNoStreamResult)
+	}
+	return lines[len(lines)-1], lines[:len(lines)-1], nil
+}
+
+// readProgressStream returns the result of a streamed create response, and
+// records the last progress lines before it.
+func (e *external) readProgressStream(cr *v1alpha1.PortOrder, body string) (string, error) {
+	result, progress, err := splitProgressStream(body)
+	if err != nil {
+		return "", err
+	}
+	if len(progress) > maxProgressLines {
+		progress = progress[len(progress)-maxProgressLines:]
+	}
+	var recorded []string
+	for _, line := range progress {
+		recorded = append(recorded, e.redactor.redact(line))
+	}
+	cr.Status.AtProvider.CreateProgress = recorded
+	return result, nil
+}
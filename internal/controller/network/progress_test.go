@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+func Test_splitProgressStream(t *testing.T) {
+	type want struct {
+		result   string
+		progress []string
+		err      error
+	}
+
+	cases := map[string]struct {
+		body string
+		want want
+	}{
+		"SingleObject": {
+			body: `{"orderId":"order-123"}`,
+			want: want{result: `{"orderId":"order-123"}`},
+		},
+		"Progress": {
+			body: "{\"progress\":10}\n\n{\"progress\":90}\r\n{\"orderId\":\"order-123\"}\n",
+			want: want{
+				result:   `{"orderId":"order-123"}`,
+				progress: []string{`{"progress":10}`, `{"progress":90}`},
+			},
+		},
+		"TruncatedLastLine": {
+			body: "{\"progress\":10}\n{\"orderId\":\"order-123\"}\n{\"progress\":",
+			want: want{
+				result:   `{"orderId":"order-123"}`,
+				progress: []string{`{"progress":10}`},
+			},
+		},
+		"NoCompleteLine": {
+			body: "{\"progress\":",
+			want: want{err: errors.New(errNoStreamResult)},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			got.result, got.progress, got.err = splitProgressStream(tc.body)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors(), cmpopts.EquateEmpty(), cmp.AllowUnexported(want{})); diff != "" {
+				t.Fatalf("splitProgressStream(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Create_StreamingResponse(t *testing.T) {
+	var lines []string
+	for i := 1; i <= maxProgressLines+2; i++ {
+		lines = append(lines, fmt.Sprintf(`{"progress":%d}`, i))
+	}
+	body := strings.Join(append(lines, `{"orderId":"order-123","status":"pending"}`), "\n")
+
+	cr := portOrder(func(po *v1alpha1.PortOrder) {
+		po.Spec.ForProvider.StreamingResponse = true
+	})
+	e := &external{
+		client: &MockHttpClient{MockSendRequest: respondWith(201, body)},
+		logger: logging.NewNopLogger(),
+	}
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("e.Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(testOrderID, cr.Status.AtProvider.OrderID); diff != "" {
+		t.Errorf("e.Create(...): -want OrderID, +got OrderID: %s", diff)
+	}
+	if diff := cmp.Diff(lines[2:], cr.Status.AtProvider.CreateProgress); diff != "" {
+		t.Errorf("e.Create(...): -want CreateProgress, +got CreateProgress: %s", diff)
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	"github.com/crossplane-contrib/provider-http/internal/utils"
+)
+
+const (
+	errNoTicket          = "create response does not contain a ticket at %q"
+	errQueueResult       = "cannot read the result of queued order ticket %s"
+	errQueueResultStatus = "reading the result of queued order ticket %s returned status code %d, body: %s"
+	errQueueGraphQL      = "queued orders are not supported with GraphQL"
+
+	defaultTicketPath = "ticket"
+	defaultStatePath  = "state"
+)
+
+// recordTicket records the ticket of the job an order was queued as, read
+// from the create response.
+func recordTicket(cr *v1alpha1.PortOrder, q v1alpha1.OrderQueue, body string) error {
+	path := q.TicketPath
+	if path == "" {
+		path = defaultTicketPath
+	}
+	ticket, err := orderStatus(body, path)
+	if err != nil {
+		return err
+	}
+	if ticket == "" {
+		return errors.Errorf(errNoTicket, path)
+	}
+	cr.Status.AtProvider.Ticket = ticket
+	cr.Status.AtProvider.QueueState = ""
+	cr.SetConditions(v1alpha1.Queued(ticket, ""))
+	return nil
+}
+
+// observeTicket reads the result of the job the order was queued as. Once
+// the job completed, it records the order the result identifies and reports
+// true, so that the order is observed like any other. Otherwise it returns
+// the observation of the queued order.
+func (e *external) observeTicket(ctx context.Context, cr *v1alpha1.PortOrder, q v1alpha1.OrderQueue) (managed.ExternalObservation, bool, error) {
+	ticket := cr.Status.AtProvider.Ticket
+	if err := validateEndpoint(q.ResultsEndpoint); err != nil {
+		return managed.ExternalObservation{}, false, err
+	}
+
+	details, err := e.send(ctx, http.MethodGet, orderURL(q.ResultsEndpoint, ticket), "", nil)
+	if err != nil {
+		return managed.ExternalObservation{}, false, errors.Wrapf(err, errQueueResult, ticket)
+	}
+	cr.Status.AtProvider.LastResponseStatus = details.HttpResponse.StatusCode
+	if !utils.IsHTTPSuccess(details.HttpResponse.StatusCode) {
+		return managed.ExternalObservation{}, false, errors.Errorf(errQueueResultStatus, ticket, details.HttpResponse.StatusCode, details.HttpResponse.Body)
+	}
+
+	path := q.StatePath
+	if path == "" {
+		path = defaultStatePath
+	}
+	state, err := orderStatus(details.HttpResponse.Body, path)
+	if err != nil {
+		return managed.ExternalObservation{}, false, errors.Wrapf(err, errQueueResult, ticket)
+	}
+	cr.Status.AtProvider.QueueState = state
+
+	// Queued orders that did not make it are not submitted again until their
+	// spec changes, like orders the backend rejected outright.
+	exists := managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}
+	switch state {
+	case v1alpha1.QueueStateCompleted:
+	case v1alpha1.QueueStateRejected, v1alpha1.QueueStateFailed:
+		cr.Status.AtProvider.StatusReason = e.redactor.redact(responseReason(details.HttpResponse.Body))
+		cr.Status.AtProvider.RejectedGeneration = cr.GetGeneration()
+		cr.Status.AtProvider.Ticket = ""
+		cr.SetConditions(queueCondition(cr))
+		return exists, false, nil
+	default:
+		cr.SetConditions(v1alpha1.Queued(ticket, state))
+		return exists, false, nil
+	}
+
+	order, err := parseOrder(cr.Spec.ForProvider, details.HttpResponse.Body)
+	if err != nil {
+		return managed.ExternalObservation{}, false, errors.Wrapf(err, errQueueResult, ticket)
+	}
+	if len(order.OrderID) == 0 {
+		return managed.ExternalObservation{}, false, errors.New(errNoOrder)
+	}
+	cr.Status.AtProvider.OrderID = order.OrderID.first()
+	if len(order.OrderID) > 1 {
+		cr.Status.AtProvider.OrderIDs = order.OrderID
+	}
+	meta.SetExternalName(cr, order.OrderID.first())
+	return managed.ExternalObservation{}, true, nil
+}
+
+// queueCondition returns the Ready condition of an order whose queued job was
+// rejected or failed.
+func queueCondition(cr *v1alpha1.PortOrder) xpv1.Condition {
+	if cr.Status.AtProvider.QueueState == v1alpha1.QueueStateFailed {
+		return v1alpha1.JobFailed(cr.Status.AtProvider.StatusReason)
+	}
+	return v1alpha1.QueueRejected(cr.Status.AtProvider.StatusReason)
+}
+
+// rejectedCondition returns the Ready condition of an order that is not
+// submitted again until its spec changes.
+func rejectedCondition(cr *v1alpha1.PortOrder) xpv1.Condition {
+	switch s := cr.Status.AtProvider.QueueState; {
+	case s == v1alpha1.QueueStateRejected, s == v1alpha1.QueueStateFailed:
+		return queueCondition(cr)
+	case cr.Status.AtProvider.LastResponseStatus == 0:
+		return v1alpha1.OrderNotSubmitted(cr.Status.AtProvider.StatusReason)
+	default:
+		return v1alpha1.OrderRejected(cr.Status.AtProvider.LastResponseStatus)
+	}
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+const (
+	testResultsEndpoint = "https://api.example.com/jobs"
+	testTicket          = "job-7"
+)
+
+func withQueue(po *v1alpha1.PortOrder) {
+	po.Spec.ForProvider.Queue = &v1alpha1.OrderQueue{ResultsEndpoint: testResultsEndpoint}
+}
+
+func withTicket(po *v1alpha1.PortOrder) {
+	po.Status.AtProvider.Ticket = testTicket
+}
+
+func Test_recordTicket(t *testing.T) {
+	type want struct {
+		ticket string
+		err    error
+	}
+
+	cases := map[string]struct {
+		queue v1alpha1.OrderQueue
+		body  string
+		want  want
+	}{
+		"DefaultPath": {
+			body: `{"ticket":"job-7"}`,
+			want: want{ticket: testTicket},
+		},
+		"CustomPath": {
+			queue: v1alpha1.OrderQueue{TicketPath: "job.id"},
+			body:  `{"job":{"id":"job-7"}}`,
+			want:  want{ticket: testTicket},
+		},
+		"Missing": {
+			body: `{"status":"accepted"}`,
+			want: want{err: errors.Errorf(errNoTicket, defaultTicketPath)},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			cr := portOrder()
+			err := recordTicket(cr, tc.queue, tc.body)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("recordTicket(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ticket, cr.Status.AtProvider.Ticket); diff != "" {
+				t.Errorf("recordTicket(...): -want ticket, +got ticket: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Create_Queue(t *testing.T) {
+	cr := portOrder(withQueue)
+	e := &external{
+		client: &MockHttpClient{MockSendRequest: respondWith(201, `{"ticket":"job-7"}`)},
+		logger: logging.NewNopLogger(),
+	}
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("e.Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(testTicket, cr.Status.AtProvider.Ticket); diff != "" {
+		t.Errorf("e.Create(...): -want ticket, +got ticket: %s", diff)
+	}
+	if cr.Status.AtProvider.OrderID != "" {
+		t.Errorf("e.Create(...): queued order has OrderID %q", cr.Status.AtProvider.OrderID)
+	}
+	if diff := cmp.Diff(v1alpha1.Queued(testTicket, ""), cr.GetCondition(xpv1.TypeReady), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+		t.Errorf("e.Create(...): -want Ready condition, +got Ready condition: %s", diff)
+	}
+}
+
+func Test_external_Observe_Queue(t *testing.T) {
+	type want struct {
+		obs       managed.ExternalObservation
+		err       error
+		orderID   string
+		ticket    string
+		rejected  int64
+		condition xpv1.Condition
+	}
+
+	order := `{"orderId":"order-123","status":"active","source":"10.0.0.0/24","destination":"10.0.1.0/24"}`
+	exists := managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}
+
+	cases := map[string]struct {
+		cr     *v1alpha1.PortOrder
+		result string
+		code   int
+		want   want
+	}{
+		"Pending": {
+			cr:     portOrder(withQueue, withTicket),
+			result: `{"state":"running"}`,
+			code:   200,
+			want: want{
+				obs:       exists,
+				ticket:    testTicket,
+				condition: v1alpha1.Queued(testTicket, "running"),
+			},
+		},
+		"Completed": {
+			cr:     portOrder(withQueue, withTicket),
+			result: `{"state":"completed","orderId":"order-123"}`,
+			code:   200,
+			want: want{
+				obs:       exists,
+				orderID:   testOrderID,
+				ticket:    testTicket,
+				condition: xpv1.Available(),
+			},
+		},
+		"Rejected": {
+			cr:     portOrder(withQueue, withTicket, withGeneration(2)),
+			result: `{"state":"rejected","reason":"port 22 is not allowed"}`,
+			code:   200,
+			want: want{
+				obs:       exists,
+				rejected:  2,
+				condition: v1alpha1.QueueRejected("port 22 is not allowed"),
+			},
+		},
+		"Failed": {
+			cr:     portOrder(withQueue, withTicket, withGeneration(2)),
+			result: `{"state":"failed","reason":"firewall unreachable"}`,
+			code:   200,
+			want: want{
+				obs:       exists,
+				rejected:  2,
+				condition: v1alpha1.JobFailed("firewall unreachable"),
+			},
+		},
+		"StillRejected": {
+			cr: portOrder(withQueue, withGeneration(2), func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.RejectedGeneration = 2
+				po.Status.AtProvider.QueueState = v1alpha1.QueueStateFailed
+				po.Status.AtProvider.StatusReason = "firewall unreachable"
+			}),
+			want: want{
+				obs:       exists,
+				rejected:  2,
+				condition: v1alpha1.JobFailed("firewall unreachable"),
+			},
+		},
+		"ResultError": {
+			cr:     portOrder(withQueue, withTicket),
+			result: "unavailable",
+			code:   503,
+			want: want{
+				err:       errors.Errorf(errQueueResultStatus, testTicket, 503, "unavailable"),
+				ticket:    testTicket,
+				condition: xpv1.Condition{Type: xpv1.TypeReady, Status: "Unknown"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: func(ctx context.Context, method string, url string, body httpclient.Data, headers httpclient.Data, skip bool) (httpclient.HttpDetails, error) {
+					if strings.HasPrefix(url, testResultsEndpoint) {
+						return respondWith(tc.code, tc.result)(ctx, method, url, body, headers, skip)
+					}
+					return respondWith(200, order)(ctx, method, url, body, headers, skip)
+				}},
+				logger: logging.NewNopLogger(),
+			}
+
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.Observe(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("e.Observe(...): -want, +got: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.orderID, tc.cr.Status.AtProvider.OrderID); diff != "" {
+				t.Errorf("e.Observe(...): -want OrderID, +got OrderID: %s", diff)
+			}
+			if tc.want.orderID != "" {
+				if diff := cmp.Diff(tc.want.orderID, meta.GetExternalName(tc.cr)); diff != "" {
+					t.Errorf("e.Observe(...): -want external name, +got external name: %s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.ticket, tc.cr.Status.AtProvider.Ticket); diff != "" {
+				t.Errorf("e.Observe(...): -want ticket, +got ticket: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.rejected, tc.cr.Status.AtProvider.RejectedGeneration); diff != "" {
+				t.Errorf("e.Observe(...): -want RejectedGeneration, +got RejectedGeneration: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, tc.cr.GetCondition(xpv1.TypeReady), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("e.Observe(...): -want Ready condition, +got Ready condition: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	"github.com/crossplane-contrib/provider-http/internal/utils"
+)
+
+const (
+	errQuotaCheck           = "cannot check tenant rule quota"
+	errQuotaStatusCode      = "quota endpoint returned status code %d"
+	errQuotaNoRemaining     = "quota response has no number at %q"
+	errQuotaExceeded        = "order needs %d rules but only %d remain in the tenant rule quota"
+	errQuotaExceededOfLimit = "order needs %d rules but only %d of the %d rules of the tenant rule quota remain"
+
+	defaultQuotaRemainingPath = "remaining"
+	defaultQuotaLimitPath     = "limit"
+)
+
+// orderRules returns the number of rules the order creates, one per port.
+func orderRules(p v1alpha1.PortOrderParameters) int64 {
+	return int64(len(p.Ports))
+}
+
+// checkQuota records the remaining rule quota of the tenant. If the rules of
+// the order exceed it, the order is not submitted until its spec changes and
+// an error with the quota details is returned.
+func (e *external) checkQuota(ctx context.Context, cr *v1alpha1.PortOrder, q v1alpha1.QuotaCheck) error {
+	if err := validateEndpoint(q.Endpoint); err != nil {
+		return err
+	}
+
+	details, err := e.send(ctx, http.MethodGet, q.Endpoint, "", nil)
+	if err != nil {
+		return errors.Wrap(err, errQuotaCheck)
+	}
+	if !utils.IsHTTPSuccess(details.HttpResponse.StatusCode) {
+		return errors.Errorf(errQuotaStatusCode, details.HttpResponse.StatusCode)
+	}
+
+	decoded := map[string]interface{}{}
+	if err := decodeJSON(details.HttpResponse.Body, &decoded); err != nil {
+		return errors.Wrap(err, errQuotaCheck)
+	}
+
+	remainingPath := q.RemainingPath
+	if remainingPath == "" {
+		remainingPath = defaultQuotaRemainingPath
+	}
+	remaining, ok := quotaNumber(decoded, remainingPath)
+	if !ok {
+		return errors.Errorf(errQuotaNoRemaining, remainingPath)
+	}
+	cr.Status.AtProvider.QuotaRemaining = &remaining
+
+	needed := orderRules(cr.Spec.ForProvider)
+	if needed <= remaining {
+		return nil
+	}
+
+	limitPath := q.LimitPath
+	if limitPath == "" {
+		limitPath = defaultQuotaLimitPath
+	}
+	if limit, ok := quotaNumber(decoded, limitPath); ok {
+		return notSubmitted(cr, errors.Errorf(errQuotaExceededOfLimit, needed, remaining, limit))
+	}
+	return notSubmitted(cr, errors.Errorf(errQuotaExceeded, needed, remaining))
+}
+
+// quotaNumber returns the integer at the path of a decoded quota response.
+func quotaNumber(body map[string]interface{}, path string) (int64, bool) {
+	v, ok := lookupField(body, path)
+	if !ok {
+		return 0, false
+	}
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	i, err := n.Int64()
+	return i, err == nil
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+const testQuotaEndpoint = "https://api.example.com/quota"
+
+func Test_external_checkQuota(t *testing.T) {
+	twoPorts := []v1alpha1.PortParameters{{Type: "tcp", Number: 443}, {Type: "tcp", Number: 8443}}
+
+	type want struct {
+		remaining *int64
+		rejected  int64
+		err       error
+	}
+
+	cases := map[string]struct {
+		http  httpclient.Client
+		ports []v1alpha1.PortParameters
+		quota v1alpha1.QuotaCheck
+		want  want
+	}{
+		"WithinQuota": {
+			http:  &MockHttpClient{MockSendRequest: respondWith(200, `{"remaining":5,"limit":100}`)},
+			ports: twoPorts,
+			want:  want{remaining: ptr.To[int64](5)},
+		},
+		"UsesRemainingQuota": {
+			http:  &MockHttpClient{MockSendRequest: respondWith(200, `{"remaining":2,"limit":100}`)},
+			ports: twoPorts,
+			want:  want{remaining: ptr.To[int64](2)},
+		},
+		"Exceeded": {
+			http:  &MockHttpClient{MockSendRequest: respondWith(200, `{"remaining":1,"limit":100}`)},
+			ports: twoPorts,
+			want: want{
+				remaining: ptr.To[int64](1),
+				rejected:  3,
+				err:       errors.Errorf(errQuotaExceededOfLimit, 2, 1, 100),
+			},
+		},
+		"ExceededWithoutLimit": {
+			http:  &MockHttpClient{MockSendRequest: respondWith(200, `{"remaining":0}`)},
+			ports: twoPorts,
+			want: want{
+				remaining: ptr.To[int64](0),
+				rejected:  3,
+				err:       errors.Errorf(errQuotaExceeded, 2, 0),
+			},
+		},
+		"CustomPaths": {
+			http:  &MockHttpClient{MockSendRequest: respondWith(200, `{"rules":{"free":1,"max":10}}`)},
+			ports: twoPorts,
+			quota: v1alpha1.QuotaCheck{RemainingPath: "rules.free", LimitPath: "rules.max"},
+			want: want{
+				remaining: ptr.To[int64](1),
+				rejected:  3,
+				err:       errors.Errorf(errQuotaExceededOfLimit, 2, 1, 10),
+			},
+		},
+		"NoRemaining": {
+			http: &MockHttpClient{MockSendRequest: respondWith(200, `{"remaining":"plenty"}`)},
+			want: want{err: errors.Errorf(errQuotaNoRemaining, "remaining")},
+		},
+		"UnexpectedStatus": {
+			http: &MockHttpClient{MockSendRequest: respondWith(500, "")},
+			want: want{err: errors.Errorf(errQuotaStatusCode, 500)},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.http, logger: logging.NewNopLogger()}
+			cr := portOrder(withGeneration(3), func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.Ports = tc.ports
+			})
+			q := tc.quota
+			q.Endpoint = testQuotaEndpoint
+
+			err := e.checkQuota(context.Background(), cr, q)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.checkQuota(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remaining, cr.Status.AtProvider.QuotaRemaining); diff != "" {
+				t.Fatalf("e.checkQuota(...): -want remaining, +got remaining: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.rejected, cr.Status.AtProvider.RejectedGeneration); diff != "" {
+				t.Errorf("e.checkQuota(...): -want RejectedGeneration, +got RejectedGeneration: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	"github.com/crossplane-contrib/provider-http/internal/utils"
+)
+
+const (
+	errProbeReadiness  = "failed to probe order readiness"
+	errProbeStatusCode = "readiness endpoint of order %s returned status code %d"
+)
+
+// probesReadiness reports whether readiness of the order is read from its
+// readiness endpoint rather than from its status.
+func probesReadiness(p v1alpha1.PortOrderParameters) bool {
+	return p.ReadinessPath != "" && !isGraphQL(p)
+}
+
+// readinessURL returns the URL of the readiness endpoint of an order.
+func readinessURL(endpoint, id, path string) string {
+	return orderURL(endpoint, id) + "/" + strings.TrimPrefix(path, "/")
+}
+
+// probeReadiness reports whether the readiness endpoint of every rule object
+// of the order reports it as ready. Any status code but a 2xx means the order
+// is not ready yet, apart from server errors, which fail the probe.
+func (e *external) probeReadiness(ctx context.Context, cr *v1alpha1.PortOrder, endpoint string) (bool, error) {
+	for _, id := range orderIDs(cr) {
+		details, err := e.send(ctx, http.MethodGet, readinessURL(endpoint, id, cr.Spec.ForProvider.ReadinessPath), "", nil)
+		if err != nil {
+			return false, errors.Wrap(err, errProbeReadiness)
+		}
+		code := details.HttpResponse.StatusCode
+		if code >= http.StatusInternalServerError {
+			return false, errors.Errorf(errProbeStatusCode, id, code)
+		}
+		if !utils.IsHTTPSuccess(code) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
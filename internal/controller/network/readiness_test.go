@@ -0,0 +1,127 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+func Test_external_Observe_ReadinessPath(t *testing.T) {
+	type want struct {
+		err   error
+		ready xpv1.Condition
+		urls  []string
+	}
+
+	cases := map[string]struct {
+		path   string
+		status string
+		probe  int
+		want   want
+	}{
+		"StatusReady": {
+			status: "active",
+			want: want{
+				ready: xpv1.Available(),
+				urls:  []string{testEndpoint + "/order-123"},
+			},
+		},
+		"ProbeReady": {
+			path:   "readiness",
+			status: "provisioning",
+			probe:  200,
+			want: want{
+				ready: xpv1.Available(),
+				urls:  []string{testEndpoint + "/order-123", testEndpoint + "/order-123/readiness"},
+			},
+		},
+		"ProbeNotReady": {
+			path:   "/readiness",
+			status: "active",
+			probe:  425,
+			want: want{
+				ready: xpv1.Unavailable(),
+				urls:  []string{testEndpoint + "/order-123", testEndpoint + "/order-123/readiness"},
+			},
+		},
+		"ProbeFailed": {
+			path:   "readiness",
+			status: "active",
+			probe:  503,
+			want: want{
+				err:  errors.Errorf(errProbeStatusCode, testOrderID, 503),
+				urls: []string{testEndpoint + "/order-123", testEndpoint + "/order-123/readiness"},
+			},
+		},
+		"OrderFailed": {
+			path:   "readiness",
+			status: "rejected",
+			probe:  200,
+			want: want{
+				ready: v1alpha1.OrderFailed("rejected", ""),
+				urls:  []string{testEndpoint + "/order-123"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			var urls []string
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: func(_ context.Context, _ string, url string, _ httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+					urls = append(urls, url)
+					if url == testEndpoint+"/order-123" {
+						return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 200, Body: `{"orderId":"order-123","status":"` + tc.status + `"}`}}, nil
+					}
+					return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: tc.probe}}, nil
+				}},
+				logger: logging.NewNopLogger(),
+			}
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.ReadinessPath = tc.path
+				po.Status.AtProvider.OrderID = testOrderID
+			})
+
+			_, err := e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.Observe(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.urls, urls); diff != "" {
+				t.Errorf("e.Observe(...): -want URLs, +got URLs: %s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.ready, cr.GetCondition(xpv1.TypeReady), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("e.Observe(...): -want Ready condition, +got Ready condition: %s", diff)
+			}
+		})
+	}
+}
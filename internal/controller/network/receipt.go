@@ -0,0 +1,60 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+// defaultMaxReceiptBytes is the receipt size limit applied when a PortOrder
+// does not configure its own.
+const defaultMaxReceiptBytes int64 = 64 << 10
+
+// reasonReceiptTooLarge is the reason of the event recorded when the receipt
+// of an order exceeds its maximum size.
+const reasonReceiptTooLarge event.Reason = "ReceiptTooLarge"
+
+// maxReceiptBytes returns the receipt size limit for the supplied order.
+func maxReceiptBytes(p v1alpha1.PortOrderParameters) int64 {
+	if p.MaxReceiptBytes != nil {
+		return *p.MaxReceiptBytes
+	}
+	return defaultMaxReceiptBytes
+}
+
+// recordReceipt stores the signed receipt the backend returned for the order,
+// if any, as it is. Backends may return the receipt only once, e.g. in the
+// create response, so a stored receipt is kept while none is returned.
+// Receipts larger than the maximum size are not stored, but recorded as a
+// warning event, since the status of the PortOrder is size limited too.
+func (e *external) recordReceipt(cr *v1alpha1.PortOrder, receipt string) {
+	if receipt == "" {
+		return
+	}
+	if limit := maxReceiptBytes(cr.Spec.ForProvider); int64(len(receipt)) > limit {
+		if e.recorder != nil {
+			e.recorder.Event(cr, event.Warning(reasonReceiptTooLarge, errors.Errorf(
+				"receipt of %d bytes exceeds the maximum size of %d bytes and is not stored", len(receipt), limit)))
+		}
+		return
+	}
+	cr.Status.AtProvider.Receipt = receipt
+}
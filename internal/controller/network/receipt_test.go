@@ -0,0 +1,102 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+const testReceipt = "c2lnbmVkLXJlY2VpcHQ="
+
+func Test_external_Observe_Receipt(t *testing.T) {
+	type want struct {
+		receipt string
+		events  []string
+	}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.PortOrder
+		body string
+		want want
+	}{
+		"Returned": {
+			cr:   portOrder(),
+			body: `{"orderId":"order-123","status":"active","receipt":"` + testReceipt + `"}`,
+			want: want{receipt: testReceipt},
+		},
+		"NotReturned": {
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.Receipt = testReceipt
+			}),
+			body: `{"orderId":"order-123","status":"active"}`,
+			want: want{receipt: testReceipt},
+		},
+		"TooLarge": {
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.MaxReceiptBytes = ptr.To[int64](8)
+			}),
+			body: `{"orderId":"order-123","status":"active","receipt":"` + testReceipt + `"}`,
+			want: want{events: []string{"receipt of 20 bytes exceeds the maximum size of 8 bytes and is not stored"}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			tc.cr.Status.AtProvider.OrderID = testOrderID
+			r := &eventRecorder{}
+			e := &external{
+				client:   &MockHttpClient{MockSendRequest: respondWith(200, tc.body)},
+				logger:   logging.NewNopLogger(),
+				recorder: r,
+			}
+
+			if _, err := e.Observe(context.Background(), tc.cr); err != nil {
+				t.Fatalf("e.Observe(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.receipt, tc.cr.Status.AtProvider.Receipt); diff != "" {
+				t.Errorf("e.Observe(...): -want Receipt, +got Receipt: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.events, r.messages); diff != "" {
+				t.Errorf("e.Observe(...): -want events, +got events: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Create_Receipt(t *testing.T) {
+	cr := portOrder()
+	e := &external{
+		client: &MockHttpClient{MockSendRequest: respondWith(201, `{"orderId":"order-123","status":"pending","receipt":"`+testReceipt+`"}`)},
+		logger: logging.NewNopLogger(),
+	}
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("e.Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(testReceipt, cr.Status.AtProvider.Receipt); diff != "" {
+		t.Errorf("e.Create(...): -want Receipt, +got Receipt: %s", diff)
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	// redacted replaces the sensitive text of backend error messages.
+	redacted = "[REDACTED]"
+
+	// redactGroup names the subexpression of a redaction pattern that is
+	// redacted. Patterns without it are redacted as a whole.
+	redactGroup = "secret"
+)
+
+// defaultRedactPatterns match common tokens and secrets.
+var defaultRedactPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)\bbearer\s+(?P<secret>[A-Za-z0-9._~+/=-]+)`),
+	regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`),
+	regexp.MustCompile(`(?i)\b(?:access[_-]?token|refresh[_-]?token|client[_-]?secret|api[_-]?key|token|secret|password|passwd)"?\s*[:=]\s*"?(?P<secret>[^"\s,;&}]+)`),
+}
+
+// redactor redacts sensitive text from the messages of the backend before
+// they are stored in the status of an order or emitted as events. A nil
+// redactor does not redact anything.
+type redactor struct {
+	patterns []*regexp.Regexp
+}
+
+// newRedactor returns a redactor of common tokens and secrets, and of the
+// supplied patterns.
+func newRedactor(patterns ...*regexp.Regexp) *redactor {
+	return &redactor{patterns: append(append([]*regexp.Regexp{}, defaultRedactPatterns...), patterns...)}
+}
+
+// redact replaces the matches of each pattern in s, or only their secret
+// subexpression if they have one.
+func (r *redactor) redact(s string) string {
+	if r == nil {
+		return s
+	}
+	for _, p := range r.patterns {
+		group := p.SubexpIndex(redactGroup)
+		matches := p.FindAllStringSubmatchIndex(s, -1)
+		if len(matches) == 0 {
+			continue
+		}
+
+		var b strings.Builder
+		last := 0
+		for _, m := range matches {
+			start, end := m[0], m[1]
+			if group > 0 && m[2*group] >= 0 {
+				start, end = m[2*group], m[2*group+1]
+			}
+			b.WriteString(s[last:start])
+			b.WriteString(redacted)
+			last = end
+		}
+		b.WriteString(s[last:])
+		s = b.String()
+	}
+	return s
+}
+
+// redactError returns err with its message redacted. Errors without
+// sensitive text are returned unchanged.
+func (r *redactor) redactError(err error) error {
+	if err == nil {
+		return nil
+	}
+	msg := r.redact(err.Error())
+	if msg == err.Error() {
+		return err
+	}
+	return errors.New(msg)
+}
+
+// redactedExternal wraps an ExternalClient, redacting the errors of its
+// operations, which the managed reconciler records in the conditions and
+// events of the order.
+type redactedExternal struct {
+	managed.ExternalClient
+	redactor *redactor
+}
+
+func (r *redactedExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	obs, err := r.ExternalClient.Observe(ctx, mg)
+	return obs, r.redactor.redactError(err)
+}
+
+func (r *redactedExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := r.ExternalClient.Create(ctx, mg)
+	return c, r.redactor.redactError(err)
+}
+
+func (r *redactedExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := r.ExternalClient.Update(ctx, mg)
+	return u, r.redactor.redactError(err)
+}
+
+func (r *redactedExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	return r.redactor.redactError(r.ExternalClient.Delete(ctx, mg))
+}
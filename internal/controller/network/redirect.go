@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+const errRedirected = "orders API redirected %s to %s, which is not followed"
+
+// reasonRedirected is the reason of the event recorded when the orders API
+// redirects a request of an order somewhere new.
+const reasonRedirected event.Reason = "Redirected"
+
+// followsRedirects reports whether redirects of the orders API are followed,
+// which they are by default.
+func followsRedirects(p v1alpha1.PortOrderParameters) bool {
+	return p.FollowRedirects == nil || *p.FollowRedirects
+}
+
+// redirectLocation returns where the response redirects the request to, if
+// it is a redirect that was not followed.
+func redirectLocation(resp httpclient.HttpResponse) (string, bool) {
+	switch resp.StatusCode {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther,
+		http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		loc := headerValue(resp.Headers, "Location")
+		return loc, loc != ""
+	default:
+		return "", false
+	}
+}
+
+// withoutQuery returns the URL without its query and credentials, which may
+// hold signatures or tokens.
+func withoutQuery(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	u.User = nil
+	u.RawQuery = ""
+	u.ForceQuery = false
+	return u.String()
+}
+
+// recordRedirect records where the orders API last redirected a request of
+// the order to, recording a warning event when it is somewhere new.
+func (e *external) recordRedirect(cr *v1alpha1.PortOrder) {
+	if e.redirectedURL == "" || e.redirectedURL == cr.Status.AtProvider.RedirectedURL {
+		return
+	}
+	cr.Status.AtProvider.RedirectedURL = e.redirectedURL
+	if e.recorder != nil {
+		e.recorder.Event(cr, event.Warning(reasonRedirected, errors.Errorf("orders API redirected a request to %s", e.redirectedURL)))
+	}
+}
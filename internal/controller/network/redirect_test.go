@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+func Test_external_Observe_Redirect(t *testing.T) {
+	const moved = "https://new.example.com/orders/order-123"
+
+	type want struct {
+		err        error
+		redirected string
+		events     []string
+	}
+
+	cases := map[string]struct {
+		resp httpclient.HttpResponse
+		cr   *v1alpha1.PortOrder
+		want want
+	}{
+		"NotRedirected": {
+			resp: httpclient.HttpResponse{StatusCode: 200, Body: `{"orderId":"order-123","status":"active"}`},
+			cr:   portOrder(withObservedEndpoints("", "")),
+		},
+		"Followed": {
+			resp: httpclient.HttpResponse{
+				StatusCode:    200,
+				Body:          `{"orderId":"order-123","status":"active"}`,
+				RedirectedURL: moved + "?signature=secret",
+			},
+			cr: portOrder(withObservedEndpoints("", "")),
+			want: want{
+				redirected: moved,
+				events:     []string{"orders API redirected a request to " + moved},
+			},
+		},
+		"FollowedAgain": {
+			resp: httpclient.HttpResponse{
+				StatusCode:    200,
+				Body:          `{"orderId":"order-123","status":"active"}`,
+				RedirectedURL: moved,
+			},
+			cr: portOrder(withObservedEndpoints("", ""), func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.RedirectedURL = moved
+			}),
+			want: want{redirected: moved},
+		},
+		"NotFollowed": {
+			resp: httpclient.HttpResponse{
+				StatusCode: 301,
+				Headers:    map[string][]string{"Location": {moved + "?signature=secret"}},
+			},
+			cr: portOrder(withObservedEndpoints("", "")),
+			want: want{
+				err: errors.Wrap(errors.Errorf(errRedirected, testEndpoint+"/"+testOrderID, moved), errObserve),
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			r := &eventRecorder{}
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: func(_ context.Context, _ string, _ string, _ httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+					return httpclient.HttpDetails{HttpResponse: tc.resp}, nil
+				}},
+				logger:   logging.NewNopLogger(),
+				recorder: r,
+			}
+
+			_, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.Observe(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.redirected, tc.cr.Status.AtProvider.RedirectedURL); diff != "" {
+				t.Errorf("e.Observe(...): -want RedirectedURL, +got RedirectedURL: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.events, r.messages); diff != "" {
+				t.Errorf("e.Observe(...): -want events, +got events: %s", diff)
+			}
+		})
+	}
+}
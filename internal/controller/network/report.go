@@ -0,0 +1,53 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+// recordFinalizationReport writes the finalization report of an order that
+// reached a ready or failed status. The report is written once, on the first
+// observation of a terminal status, and kept afterwards. It reports whether
+// the report was written.
+func recordFinalizationReport(cr *v1alpha1.PortOrder, now time.Time) bool {
+	o := &cr.Status.AtProvider
+	if o.FinalizationReport != nil || (!isReady(cr) && !isFailed(cr)) {
+		return false
+	}
+
+	ports := make([]string, 0, len(cr.Spec.ForProvider.Ports))
+	for _, p := range cr.Spec.ForProvider.Ports {
+		ports = append(ports, specPortString(p))
+	}
+
+	r := &v1alpha1.FinalizationReport{
+		OrderID:        o.OrderID,
+		Ports:          ports,
+		FinalStatus:    o.Status,
+		CompletionTime: metav1.NewTime(now),
+	}
+	if created := cr.GetCreationTimestamp(); !created.IsZero() {
+		r.DurationSeconds = int64(now.Sub(created.Time).Seconds())
+	}
+	o.FinalizationReport = r
+	return true
+}
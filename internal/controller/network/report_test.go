@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+func Test_recordFinalizationReport(t *testing.T) {
+	created := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	now := created.Add(90 * time.Second)
+	earlier := &v1alpha1.FinalizationReport{OrderID: testOrderID, FinalStatus: "active", CompletionTime: metav1.NewTime(created)}
+
+	withStatus := func(status string) func(*v1alpha1.PortOrder) {
+		return func(po *v1alpha1.PortOrder) {
+			po.SetCreationTimestamp(metav1.NewTime(created))
+			po.Status.AtProvider.OrderID = testOrderID
+			po.Status.AtProvider.Status = status
+		}
+	}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.PortOrder
+		want *v1alpha1.FinalizationReport
+	}{
+		"Pending": {
+			cr: portOrder(withStatus("pending")),
+		},
+		"Ready": {
+			cr: portOrder(withStatus("active")),
+			want: &v1alpha1.FinalizationReport{
+				OrderID:         testOrderID,
+				Ports:           []string{"tcp/443"},
+				FinalStatus:     "active",
+				CompletionTime:  metav1.NewTime(now),
+				DurationSeconds: 90,
+			},
+		},
+		"Failed": {
+			cr: portOrder(withStatus("rejected")),
+			want: &v1alpha1.FinalizationReport{
+				OrderID:         testOrderID,
+				Ports:           []string{"tcp/443"},
+				FinalStatus:     "rejected",
+				CompletionTime:  metav1.NewTime(now),
+				DurationSeconds: 90,
+			},
+		},
+		"ICMP": {
+			cr: portOrder(withStatus("active"), func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.Ports = append(po.Spec.ForProvider.Ports, v1alpha1.PortParameters{Type: "icmp"})
+			}),
+			want: &v1alpha1.FinalizationReport{
+				OrderID:         testOrderID,
+				Ports:           []string{"tcp/443", "icmp"},
+				FinalStatus:     "active",
+				CompletionTime:  metav1.NewTime(now),
+				DurationSeconds: 90,
+			},
+		},
+		"AlreadyReported": {
+			cr: portOrder(withStatus("failed"), func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.FinalizationReport = earlier
+			}),
+			want: earlier,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			recordFinalizationReport(tc.cr, now)
+			if diff := cmp.Diff(tc.want, tc.cr.Status.AtProvider.FinalizationReport); diff != "" {
+				t.Fatalf("recordFinalizationReport(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
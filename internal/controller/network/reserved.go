@@ -0,0 +1,102 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+const (
+	errPortRangeInvalid = "invalid port range %q: must be a port or an inclusive range of ports, e.g. 0-1023"
+	errPortsReserved    = "ports %s are in reserved ranges and require the %s annotation"
+)
+
+// PortRange is an inclusive range of port numbers.
+type PortRange struct {
+	From int
+	To   int
+}
+
+// ParsePortRange parses a port, e.g. "22", or an inclusive range of ports,
+// e.g. "0-1023".
+func ParsePortRange(s string) (PortRange, error) {
+	from, to, isRange := strings.Cut(strings.TrimSpace(s), "-")
+	if !isRange {
+		to = from
+	}
+	lo, err := strconv.Atoi(strings.TrimSpace(from))
+	if err != nil {
+		return PortRange{}, errors.Errorf(errPortRangeInvalid, s)
+	}
+	hi, err := strconv.Atoi(strings.TrimSpace(to))
+	if err != nil || lo < 0 || hi > 65535 || lo > hi {
+		return PortRange{}, errors.Errorf(errPortRangeInvalid, s)
+	}
+	return PortRange{From: lo, To: hi}, nil
+}
+
+// contains reports whether the range contains the port. Port 0 stands for
+// any port, so it touches every range.
+func (r PortRange) contains(port int) bool {
+	return port == 0 || (port >= r.From && port <= r.To)
+}
+
+// privileged reports whether the order may open ports in reserved ranges.
+func privileged(cr *v1alpha1.PortOrder) bool {
+	return strings.EqualFold(cr.GetAnnotations()[v1alpha1.AnnotationKeyPrivileged], "true")
+}
+
+// reservedPorts returns the ports of the order in the supplied reserved
+// ranges, sorted. It is empty for privileged orders.
+func reservedPorts(cr *v1alpha1.PortOrder, reserved []PortRange) []string {
+	if len(reserved) == 0 || privileged(cr) {
+		return nil
+	}
+
+	var ports []string
+	for _, p := range cr.Spec.ForProvider.Ports {
+		if portType(p) == v1alpha1.PortTypeICMP {
+			continue
+		}
+		for _, r := range reserved {
+			if r.contains(p.Number) {
+				ports = append(ports, specPortString(p))
+				break
+			}
+		}
+	}
+	slices.Sort(ports)
+	return slices.Compact(ports)
+}
+
+// checkReservedPorts fails orders that open ports in reserved ranges without
+// being privileged. Nothing is sent for them, so they are checked again on
+// each create until either their ports or their annotations change.
+func (e *external) checkReservedPorts(cr *v1alpha1.PortOrder) error {
+	ports := reservedPorts(cr, e.reservedPorts)
+	if len(ports) == 0 {
+		return nil
+	}
+	cr.SetConditions(v1alpha1.PortsReserved(ports))
+	return errors.Errorf(errPortsReserved, strings.Join(ports, ", "), v1alpha1.AnnotationKeyPrivileged)
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+func withPorts(ports ...v1alpha1.PortParameters) portOrderModifier {
+	return func(po *v1alpha1.PortOrder) {
+		po.Spec.ForProvider.Ports = ports
+	}
+}
+
+func withPrivileged(po *v1alpha1.PortOrder) {
+	po.SetAnnotations(map[string]string{v1alpha1.AnnotationKeyPrivileged: "true"})
+}
+
+func TestParsePortRange(t *testing.T) {
+	type want struct {
+		r   PortRange
+		err error
+	}
+
+	cases := map[string]struct {
+		s    string
+		want want
+	}{
+		"Port": {
+			s:    "22",
+			want: want{r: PortRange{From: 22, To: 22}},
+		},
+		"Range": {
+			s:    " 0-1023 ",
+			want: want{r: PortRange{From: 0, To: 1023}},
+		},
+		"Reversed": {
+			s:    "1023-0",
+			want: want{err: errors.Errorf(errPortRangeInvalid, "1023-0")},
+		},
+		"OutOfRange": {
+			s:    "60000-70000",
+			want: want{err: errors.Errorf(errPortRangeInvalid, "60000-70000")},
+		},
+		"NotANumber": {
+			s:    "ssh",
+			want: want{err: errors.Errorf(errPortRangeInvalid, "ssh")},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got, err := ParsePortRange(tc.s)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("ParsePortRange(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.r, got); diff != "" {
+				t.Errorf("ParsePortRange(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_reservedPorts(t *testing.T) {
+	reserved := []PortRange{{From: 0, To: 1023}, {From: 3306, To: 3306}}
+
+	cases := map[string]struct {
+		cr       *v1alpha1.PortOrder
+		reserved []PortRange
+		want     []string
+	}{
+		"NoneReserved": {
+			cr: portOrder(),
+		},
+		"Unreserved": {
+			cr:       portOrder(withPorts(v1alpha1.PortParameters{Type: "tcp", Number: 8443})),
+			reserved: reserved,
+		},
+		"Reserved": {
+			cr: portOrder(withPorts(
+				v1alpha1.PortParameters{Type: "tcp", Number: 3306},
+				v1alpha1.PortParameters{Type: "tcp", Number: 8443},
+				v1alpha1.PortParameters{Type: "udp", Number: 53},
+				v1alpha1.PortParameters{Type: "icmp"},
+			)),
+			reserved: reserved,
+			want:     []string{"tcp/3306", "udp/53"},
+		},
+		"AnyPort": {
+			cr:       portOrder(withPorts(v1alpha1.PortParameters{Type: "tcp", Number: 0})),
+			reserved: []PortRange{{From: 3306, To: 3306}},
+			want:     []string{"tcp/0"},
+		},
+		"Privileged": {
+			cr:       portOrder(withPrivileged),
+			reserved: reserved,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got := reservedPorts(tc.cr, tc.reserved)
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("reservedPorts(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Create_ReservedPorts(t *testing.T) {
+	type want struct {
+		err       error
+		sent      bool
+		condition xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.PortOrder
+		want want
+	}{
+		"Reserved": {
+			cr: portOrder(),
+			want: want{
+				err:       errors.Errorf(errPortsReserved, "tcp/443", v1alpha1.AnnotationKeyPrivileged),
+				condition: v1alpha1.PortsReserved([]string{"tcp/443"}),
+			},
+		},
+		"Privileged": {
+			cr: portOrder(withPrivileged),
+			want: want{
+				sent:      true,
+				condition: xpv1.Condition{Type: xpv1.TypeReady, Status: "Unknown"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			sent := false
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: func(ctx context.Context, method string, url string, body httpclient.Data, headers httpclient.Data, skip bool) (httpclient.HttpDetails, error) {
+					sent = true
+					return respondWith(201, `{"orderId":"order-123","status":"pending"}`)(ctx, method, url, body, headers, skip)
+				}},
+				logger:        logging.NewNopLogger(),
+				reservedPorts: []PortRange{{From: 0, To: 1023}},
+			}
+
+			_, err := e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.Create(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.sent, sent); diff != "" {
+				t.Errorf("e.Create(...): -want sent, +got sent: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, tc.cr.GetCondition(xpv1.TypeReady), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("e.Create(...): -want Ready condition, +got Ready condition: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,338 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+const (
+	defaultErrorMessagePath = "message"
+	defaultStatusPath       = "status"
+
+	errOrderNotAccepted     = "order was not accepted: %s"
+	errNoOrderID            = "response body is empty and no order ID was found in the %s header"
+	errSuccessFieldMismatch = "response field %q is %q, expected %q"
+)
+
+// jsonID is an identifier the backend may encode as either a JSON string or a
+// JSON number. Numbers are kept verbatim, so that 64-bit IDs beyond the
+// precision of float64 round-trip exactly.
+type jsonID string
+
+// UnmarshalJSON decodes a JSON string or number into the ID.
+func (id *jsonID) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*id = jsonID(s)
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*id = jsonID(n)
+	return nil
+}
+
+// jsonIDs are the IDs of an order. Backends that split an order into several
+// rule objects return an array of IDs, others a single ID.
+type jsonIDs []string
+
+// UnmarshalJSON decodes a single ID or an array of IDs.
+func (ids *jsonIDs) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '[' {
+		var list []jsonID
+		if err := json.Unmarshal(data, &list); err != nil {
+			return err
+		}
+		*ids = nil
+		for _, id := range list {
+			if id != "" {
+				*ids = append(*ids, string(id))
+			}
+		}
+		return nil
+	}
+
+	var id jsonID
+	if err := json.Unmarshal(data, &id); err != nil {
+		return err
+	}
+	*ids = nil
+	if id != "" {
+		*ids = jsonIDs{string(id)}
+	}
+	return nil
+}
+
+// first returns the first ID, or an empty string if there is none.
+func (ids jsonIDs) first() string {
+	if len(ids) == 0 {
+		return ""
+	}
+	return ids[0]
+}
+
+// has reports whether id is one of the IDs.
+func (ids jsonIDs) has(id string) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+// combineOrders combines the rule objects of an order into one order. The
+// order has the status of the first rule object that is not ready yet, the
+// time it entered it and the next action it needs, the lowest applied
+// percentage and poll interval of them, the devices affected by and the tags
+// of all of them, the distinct zones they were placed in, the source and
+// destination of the first of them and the first receipt any of them has.
+func combineOrders(ready []string, orders []*OrderResponse) *OrderResponse {
+	if len(orders) == 1 {
+		return orders[0]
+	}
+
+	combined := &OrderResponse{}
+	var reasons, zones []string
+	for _, o := range orders {
+		combined.OrderID = append(combined.OrderID, o.OrderID...)
+		combined.RuleIDs = append(combined.RuleIDs, o.RuleIDs...)
+		combined.Approvals = append(combined.Approvals, o.Approvals...)
+		combined.Ports = append(combined.Ports, o.Ports...)
+		if combined.Status == "" || (hasStatus(ready, combined.Status) && !hasStatus(ready, o.Status)) {
+			combined.Status = o.Status
+			combined.StatusChangedAt = o.StatusChangedAt
+			combined.NextAction = o.NextAction
+		}
+		if o.PollAfterSeconds != nil && (combined.PollAfterSeconds == nil || *o.PollAfterSeconds < *combined.PollAfterSeconds) {
+			combined.PollAfterSeconds = o.PollAfterSeconds
+		}
+		if o.AppliedPercentage != nil && (combined.AppliedPercentage == nil || *o.AppliedPercentage < *combined.AppliedPercentage) {
+			combined.AppliedPercentage = o.AppliedPercentage
+		}
+		combined.AffectedDevices += o.AffectedDevices
+		if combined.Receipt == "" {
+			combined.Receipt = o.Receipt
+		}
+		for k, v := range o.Tags {
+			if combined.Tags == nil {
+				combined.Tags = map[string]interface{}{}
+			}
+			combined.Tags[k] = v
+		}
+		if o.Reason != "" {
+			reasons = append(reasons, o.Reason)
+		}
+		if o.Zone != "" && !slices.Contains(zones, o.Zone) {
+			zones = append(zones, o.Zone)
+		}
+		if t := lastModified(o.LastModified); t != nil {
+			if latest := lastModified(combined.LastModified); latest == nil || t.After(latest.Time) {
+				combined.LastModified = o.LastModified
+			}
+		}
+		if combined.Source == "" && combined.Destination == "" {
+			combined.Source, combined.Destination = o.Source, o.Destination
+		}
+	}
+	combined.Reason = strings.Join(reasons, "; ")
+	combined.Zone = strings.Join(zones, ",")
+	return combined
+}
+
+// backendTags returns the tags the backend reports for an order as strings,
+// or nil if it reports none.
+func backendTags(tags map[string]interface{}) map[string]string {
+	if len(tags) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(tags))
+	for k, v := range tags {
+		if v == nil {
+			continue
+		}
+		out[k] = fieldString(v)
+	}
+	return out
+}
+
+// orderStatus returns the status at the dot-separated path of a response
+// body, or an empty string if it has none.
+func orderStatus(body, path string) (string, error) {
+	decoded := map[string]interface{}{}
+	if err := decodeJSON(body, &decoded); err != nil {
+		return "", errors.Wrap(err, errUnmarshal)
+	}
+	v, ok := lookupField(decoded, path)
+	if !ok || v == nil {
+		return "", nil
+	}
+	return fieldString(v), nil
+}
+
+// lastModified parses the RFC 3339 last modification time reported by the
+// backend. It returns nil if the time is absent or malformed.
+func lastModified(s string) *metav1.Time {
+	if s == "" {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil
+	}
+	mt := metav1.NewTime(t)
+	return &mt
+}
+
+// responseReason returns the reason field of a response body, or an empty
+// string if it has none.
+func responseReason(body string) string {
+	var resp struct {
+		Reason string `json:"reason"`
+	}
+	if json.Unmarshal([]byte(body), &resp) != nil {
+		return ""
+	}
+	return resp.Reason
+}
+
+// decodeJSON decodes a response body, keeping numbers decoded into
+// interface values as json.Number rather than rounding them to float64.
+func decodeJSON(body string, v interface{}) error {
+	d := json.NewDecoder(strings.NewReader(body))
+	d.UseNumber()
+	return d.Decode(v)
+}
+
+// lookupField returns the value at the dot-separated path of a decoded JSON
+// object.
+func lookupField(obj map[string]interface{}, path string) (interface{}, bool) {
+	var cur interface{} = obj
+	for _, key := range strings.Split(path, ".") {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if cur, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+// fieldString renders a decoded JSON value the way it is written in a
+// ResponseFieldMatch.
+func fieldString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return fmt.Sprint(v)
+}
+
+// matchesField reports whether the body contains the field described by m
+// with the expected value.
+func matchesField(body map[string]interface{}, m v1alpha1.ResponseFieldMatch) bool {
+	v, ok := lookupField(body, m.Path)
+	return ok && fieldString(v) == m.Value
+}
+
+// checkSuccessField verifies the create response body against the order's
+// SuccessField, returning the backend-provided error message on mismatch.
+func checkSuccessField(p v1alpha1.PortOrderParameters, body string) error {
+	if p.SuccessField == nil {
+		return nil
+	}
+
+	decoded := map[string]interface{}{}
+	if err := decodeJSON(body, &decoded); err != nil {
+		return errors.Wrap(err, errUnmarshal)
+	}
+
+	if matchesField(decoded, *p.SuccessField) {
+		return nil
+	}
+
+	msgPath := p.ErrorMessagePath
+	if msgPath == "" {
+		msgPath = defaultErrorMessagePath
+	}
+	if msg, ok := lookupField(decoded, msgPath); ok {
+		return errors.Errorf(errOrderNotAccepted, fieldString(msg))
+	}
+
+	got, _ := lookupField(decoded, p.SuccessField.Path)
+	return errors.Errorf(errOrderNotAccepted, fmt.Sprintf(errSuccessFieldMismatch, p.SuccessField.Path, fieldString(got), p.SuccessField.Value))
+}
+
+// orderFromHeaders reads the ID of an order created with an empty response
+// body from the configured order ID header, or else from the last path
+// segment of the Location header.
+func orderFromHeaders(p v1alpha1.PortOrderParameters, headers map[string][]string) (*OrderResponse, error) {
+	searched := []string{"Location"}
+	if p.OrderIDHeader != "" {
+		if id := headerValue(headers, p.OrderIDHeader); id != "" {
+			return &OrderResponse{OrderID: jsonIDs{id}}, nil
+		}
+		searched = []string{p.OrderIDHeader, "Location"}
+	}
+
+	if loc := headerValue(headers, "Location"); loc != "" {
+		// The segment is taken from the escaped path, since the ID itself
+		// may contain an escaped slash.
+		if u, err := url.Parse(loc); err == nil {
+			seg := path.Base(strings.TrimSuffix(u.EscapedPath(), "/"))
+			if id, err := url.PathUnescape(seg); err == nil && seg != "." && seg != "/" {
+				return &OrderResponse{OrderID: jsonIDs{id}}, nil
+			}
+		}
+	}
+
+	return nil, errors.Errorf(errNoOrderID, strings.Join(searched, " or "))
+}
+
+// headerValue returns the first value of the header, matching its name case
+// insensitively, or an empty string if it is absent.
+func headerValue(headers map[string][]string, name string) string {
+	for k, v := range headers {
+		if strings.EqualFold(k, name) && len(v) > 0 {
+			return strings.TrimSpace(v[0])
+		}
+	}
+	return ""
+}
@@ -0,0 +1,337 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+func Test_checkSuccessField(t *testing.T) {
+	cases := map[string]struct {
+		params v1alpha1.PortOrderParameters
+		body   string
+		want   error
+	}{
+		"NotConfigured": {
+			params: v1alpha1.PortOrderParameters{},
+			body:   `not json`,
+		},
+		"Matches": {
+			params: v1alpha1.PortOrderParameters{SuccessField: &v1alpha1.ResponseFieldMatch{Path: "result.ok", Value: "true"}},
+			body:   `{"result":{"ok":true}}`,
+		},
+		"MatchesLargeNumber": {
+			params: v1alpha1.PortOrderParameters{SuccessField: &v1alpha1.ResponseFieldMatch{Path: "batch", Value: "9007199254740993"}},
+			body:   `{"batch":9007199254740993}`,
+		},
+		"MismatchWithDefaultMessage": {
+			params: v1alpha1.PortOrderParameters{SuccessField: &v1alpha1.ResponseFieldMatch{Path: "success", Value: "true"}},
+			body:   `{"success":false,"message":"invalid CIDR"}`,
+			want:   errors.Errorf(errOrderNotAccepted, "invalid CIDR"),
+		},
+		"MismatchWithCustomMessagePath": {
+			params: v1alpha1.PortOrderParameters{
+				SuccessField:     &v1alpha1.ResponseFieldMatch{Path: "success", Value: "true"},
+				ErrorMessagePath: "error.detail",
+			},
+			body: `{"success":false,"error":{"detail":"denied"}}`,
+			want: errors.Errorf(errOrderNotAccepted, "denied"),
+		},
+		"MismatchWithoutMessage": {
+			params: v1alpha1.PortOrderParameters{SuccessField: &v1alpha1.ResponseFieldMatch{Path: "state", Value: "accepted"}},
+			body:   `{"state":"rejected"}`,
+			want:   errors.Errorf(errOrderNotAccepted, `response field "state" is "rejected", expected "accepted"`),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got := checkSuccessField(tc.params, tc.body)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Fatalf("checkSuccessField(...): -want error, +got error: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_jsonIDs(t *testing.T) {
+	cases := map[string]struct {
+		body string
+		want jsonIDs
+	}{
+		"String": {
+			body: `{"orderId":"order-123"}`,
+			want: jsonIDs{"order-123"},
+		},
+		"Number": {
+			body: `{"orderId":9007199254740993}`,
+			want: jsonIDs{"9007199254740993"},
+		},
+		"Array": {
+			body: `{"orderId":["rule-1",2,""]}`,
+			want: jsonIDs{"rule-1", "2"},
+		},
+		"Null": {
+			body: `{"orderId":null}`,
+		},
+		"Missing": {
+			body: `{}`,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			var got OrderResponse
+			if err := json.Unmarshal([]byte(tc.body), &got); err != nil {
+				t.Fatalf("json.Unmarshal(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got.OrderID); diff != "" {
+				t.Fatalf("json.Unmarshal(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_combineOrders(t *testing.T) {
+	cases := map[string]struct {
+		orders []*OrderResponse
+		want   *OrderResponse
+	}{
+		"Single": {
+			orders: []*OrderResponse{{OrderID: jsonIDs{"rule-1"}, Status: "pending", AffectedDevices: 2}},
+			want:   &OrderResponse{OrderID: jsonIDs{"rule-1"}, Status: "pending", AffectedDevices: 2},
+		},
+		"AllReady": {
+			orders: []*OrderResponse{
+				{OrderID: jsonIDs{"rule-1"}, Status: "active", AffectedDevices: 2},
+				{OrderID: jsonIDs{"rule-2"}, Status: "complete", AffectedDevices: 3},
+			},
+			want: &OrderResponse{OrderID: jsonIDs{"rule-1", "rule-2"}, Status: "active", AffectedDevices: 5},
+		},
+		"OneNotReady": {
+			orders: []*OrderResponse{
+				{OrderID: jsonIDs{"rule-1"}, Status: "active", PollAfterSeconds: ptr.To[int64](60), AppliedPercentage: ptr.To(100)},
+				{OrderID: jsonIDs{"rule-2"}, Status: "pending", PollAfterSeconds: ptr.To[int64](30), AppliedPercentage: ptr.To(50), NextAction: "awaiting-approval-from-netsec"},
+				{OrderID: jsonIDs{"rule-3"}, Status: "failed", NextAction: "resubmit"},
+			},
+			want: &OrderResponse{
+				OrderID:           jsonIDs{"rule-1", "rule-2", "rule-3"},
+				Status:            "pending",
+				PollAfterSeconds:  ptr.To[int64](30),
+				AppliedPercentage: ptr.To(50),
+				NextAction:        "awaiting-approval-from-netsec",
+			},
+		},
+		"Reasons": {
+			orders: []*OrderResponse{
+				{OrderID: jsonIDs{"rule-1"}, Status: "rejected", Reason: "port 22 is not allowed"},
+				{OrderID: jsonIDs{"rule-2"}, Status: "active"},
+				{OrderID: jsonIDs{"rule-3"}, Status: "rejected", Reason: "destination is not routable"},
+			},
+			want: &OrderResponse{
+				OrderID: jsonIDs{"rule-1", "rule-2", "rule-3"},
+				Status:  "rejected",
+				Reason:  "port 22 is not allowed; destination is not routable",
+			},
+		},
+		"LatestLastModified": {
+			orders: []*OrderResponse{
+				{OrderID: jsonIDs{"rule-1"}, Status: "active", LastModified: "2025-03-01T10:00:00Z"},
+				{OrderID: jsonIDs{"rule-2"}, Status: "active", LastModified: "2025-03-01T12:30:00+02:00"},
+				{OrderID: jsonIDs{"rule-3"}, Status: "active", LastModified: "yesterday"},
+			},
+			want: &OrderResponse{
+				OrderID:      jsonIDs{"rule-1", "rule-2", "rule-3"},
+				Status:       "active",
+				LastModified: "2025-03-01T12:30:00+02:00",
+			},
+		},
+		"MergedTags": {
+			orders: []*OrderResponse{
+				{OrderID: jsonIDs{"rule-1"}, Status: "active", Tags: map[string]interface{}{"risk": "low"}},
+				{OrderID: jsonIDs{"rule-2"}, Status: "active", Tags: map[string]interface{}{"class": "web"}},
+			},
+			want: &OrderResponse{
+				OrderID: jsonIDs{"rule-1", "rule-2"},
+				Status:  "active",
+				Tags:    map[string]interface{}{"risk": "low", "class": "web"},
+			},
+		},
+		"DistinctZones": {
+			orders: []*OrderResponse{
+				{OrderID: jsonIDs{"rule-1"}, Status: "active", Zone: "dmz-east"},
+				{OrderID: jsonIDs{"rule-2"}, Status: "active"},
+				{OrderID: jsonIDs{"rule-3"}, Status: "active", Zone: "core"},
+				{OrderID: jsonIDs{"rule-4"}, Status: "active", Zone: "dmz-east"},
+			},
+			want: &OrderResponse{
+				OrderID: jsonIDs{"rule-1", "rule-2", "rule-3", "rule-4"},
+				Status:  "active",
+				Zone:    "dmz-east,core",
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, combineOrders(defaultReadyStatuses, tc.orders)); diff != "" {
+				t.Fatalf("combineOrders(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_lastModified(t *testing.T) {
+	cases := map[string]struct {
+		value string
+		want  *metav1.Time
+	}{
+		"Absent": {},
+		"Malformed": {
+			value: "2025-03-01 10:00",
+		},
+		"UTC": {
+			value: "2025-03-01T10:00:00Z",
+			want:  &metav1.Time{Time: time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)},
+		},
+		"Offset": {
+			value: "2025-03-01T12:30:00+02:00",
+			want:  &metav1.Time{Time: time.Date(2025, 3, 1, 10, 30, 0, 0, time.UTC)},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got := lastModified(tc.value)
+			if diff := cmp.Diff(tc.want, got, cmp.Comparer(func(a, b metav1.Time) bool { return a.Equal(&b) })); diff != "" {
+				t.Fatalf("lastModified(%q): -want, +got: %s", tc.value, diff)
+			}
+		})
+	}
+}
+
+func Test_orderFromHeaders(t *testing.T) {
+	type want struct {
+		order *OrderResponse
+		err   error
+	}
+
+	cases := map[string]struct {
+		header  string
+		headers map[string][]string
+		want    want
+	}{
+		"Location": {
+			headers: map[string][]string{"Location": {"https://api.example.com/orders/order-123"}},
+			want:    want{order: &OrderResponse{OrderID: jsonIDs{testOrderID}}},
+		},
+		"RelativeLocation": {
+			headers: map[string][]string{"location": {"/orders/order%2F123/"}},
+			want:    want{order: &OrderResponse{OrderID: jsonIDs{"order/123"}}},
+		},
+		"OrderIDHeader": {
+			header: "X-Order-ID",
+			headers: map[string][]string{
+				"X-Order-Id": {"order-456"},
+				"Location":   {"https://api.example.com/orders/order-123"},
+			},
+			want: want{order: &OrderResponse{OrderID: jsonIDs{"order-456"}}},
+		},
+		"OrderIDHeaderAbsent": {
+			header:  "X-Order-ID",
+			headers: map[string][]string{"Location": {"https://api.example.com/orders/order-123"}},
+			want:    want{order: &OrderResponse{OrderID: jsonIDs{testOrderID}}},
+		},
+		"NoID": {
+			header:  "X-Order-ID",
+			headers: map[string][]string{"Location": {"https://api.example.com/"}},
+			want:    want{err: errors.Errorf(errNoOrderID, "X-Order-ID or Location")},
+		},
+		"NoHeaders": {
+			want: want{err: errors.Errorf(errNoOrderID, "Location")},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got, err := orderFromHeaders(v1alpha1.PortOrderParameters{OrderIDHeader: tc.header}, tc.headers)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("orderFromHeaders(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.order, got); diff != "" {
+				t.Fatalf("orderFromHeaders(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Observe_BackendTags(t *testing.T) {
+	cases := map[string]struct {
+		cr   *v1alpha1.PortOrder
+		body string
+		want map[string]string
+	}{
+		"Tags": {
+			cr:   portOrder(),
+			body: `{"orderId":"order-123","status":"active","tags":{"classification":"web","risk":3,"reviewed":true,"owner":null}}`,
+			want: map[string]string{"classification": "web", "risk": "3", "reviewed": "true"},
+		},
+		"NoTags": {
+			cr: portOrder(func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.BackendTags = map[string]string{"classification": "web"}
+			}),
+			body: `{"orderId":"order-123","status":"active"}`,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			tc.cr.Status.AtProvider.OrderID = testOrderID
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: respondWith(200, tc.body)},
+				logger: logging.NewNopLogger(),
+			}
+
+			if _, err := e.Observe(context.Background(), tc.cr); err != nil {
+				t.Fatalf("e.Observe(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, tc.cr.Status.AtProvider.BackendTags); diff != "" {
+				t.Errorf("e.Observe(...): -want BackendTags, +got BackendTags: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+// forceResync reports whether an order that was submitted before is to be
+// submitted again, because its force-resync annotation changed since it was
+// last processed. The new value is recorded as processed either way, so that
+// the order is submitted again only once per value. An order the backend
+// rejected is no longer held back.
+func (e *external) forceResync(cr *v1alpha1.PortOrder) bool {
+	v := cr.GetAnnotations()[v1alpha1.AnnotationKeyForceResync]
+	if v == "" || v == cr.Status.AtProvider.ForceResync {
+		return false
+	}
+	cr.Status.AtProvider.ForceResync = v
+	cr.Status.AtProvider.RejectedGeneration = 0
+	if cr.Status.AtProvider.OrderID == "" {
+		return false
+	}
+
+	// Neither the order created nor the order read before may stand in for
+	// the one submitted again.
+	e.creates.forget(cr)
+	e.orders.invalidate(orderIDs(cr)...)
+	return true
+}
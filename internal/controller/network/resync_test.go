@@ -0,0 +1,114 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+func withForceResync(annotation, processed string) portOrderModifier {
+	return func(po *v1alpha1.PortOrder) {
+		po.SetAnnotations(map[string]string{v1alpha1.AnnotationKeyForceResync: annotation})
+		po.Status.AtProvider.ForceResync = processed
+	}
+}
+
+func Test_external_Observe_ForceResync(t *testing.T) {
+	type want struct {
+		obs       managed.ExternalObservation
+		processed string
+		rejected  int64
+	}
+
+	withOrder := func(po *v1alpha1.PortOrder) {
+		po.Status.AtProvider.OrderID = testOrderID
+	}
+	exists := managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.PortOrder
+		want want
+	}{
+		"NoAnnotation": {
+			cr:   portOrder(withOrder),
+			want: want{obs: exists},
+		},
+		"NewValue": {
+			cr: portOrder(withOrder, withForceResync("2025-06-01T12:00:00Z", "2025-05-01T12:00:00Z")),
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: false},
+				processed: "2025-06-01T12:00:00Z",
+			},
+		},
+		"Processed": {
+			cr: portOrder(withOrder, withForceResync("2025-06-01T12:00:00Z", "2025-06-01T12:00:00Z")),
+			want: want{
+				obs:       exists,
+				processed: "2025-06-01T12:00:00Z",
+			},
+		},
+		"RejectedRetried": {
+			cr: portOrder(withGeneration(3), withForceResync("2025-06-01T12:00:00Z", ""), func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.RejectedGeneration = 3
+			}),
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: false},
+				processed: "2025-06-01T12:00:00Z",
+			},
+		},
+		"Deleted": {
+			cr: portOrder(withForceResync("2025-06-01T12:00:00Z", ""), func(po *v1alpha1.PortOrder) {
+				now := metav1.Now()
+				po.SetDeletionTimestamp(&now)
+			}),
+			want: want{obs: managed.ExternalObservation{ResourceExists: false}},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: respondWith(200, `{"orderId":"order-123","status":"active","source":"10.0.0.0/24","destination":"10.0.1.0/24"}`)},
+				logger: logging.NewNopLogger(),
+			}
+
+			got, err := e.Observe(context.Background(), tc.cr)
+			if err != nil {
+				t.Fatalf("e.Observe(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("e.Observe(...): -want, +got: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.processed, tc.cr.Status.AtProvider.ForceResync); diff != "" {
+				t.Errorf("e.Observe(...): -want ForceResync, +got ForceResync: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.rejected, tc.cr.Status.AtProvider.RejectedGeneration); diff != "" {
+				t.Errorf("e.Observe(...): -want RejectedGeneration, +got RejectedGeneration: %s", diff)
+			}
+		})
+	}
+}
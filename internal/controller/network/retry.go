@@ -0,0 +1,127 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+	"github.com/crossplane-contrib/provider-http/internal/utils"
+)
+
+const (
+	errRetriesExhausted = "backend still asked to retry the order after %d attempts"
+
+	// maxRetryAttempts bounds how often an order is submitted while the
+	// backend asks for it to be retried.
+	maxRetryAttempts    = 5
+	defaultRetryBackoff = time.Second
+
+	defaultTimeoutFactorPercent = 150
+	defaultTimeoutAttempts      = 3
+)
+
+// retryOnBodyMatch calls submit until its successful response no longer
+// matches the order's RetryOnBodyMatch, doubling the wait between attempts.
+func (e *external) retryOnBodyMatch(ctx context.Context, p v1alpha1.PortOrderParameters, submit func() (httpclient.HttpDetails, error)) (httpclient.HttpDetails, error) {
+	backoff := e.retryBackoff
+	for attempt := 1; ; attempt++ {
+		details, err := submit()
+		if err != nil || p.RetryOnBodyMatch == nil || !utils.IsHTTPSuccess(details.HttpResponse.StatusCode) {
+			return details, err
+		}
+
+		decoded := map[string]interface{}{}
+		if decodeJSON(details.HttpResponse.Body, &decoded) != nil || !matchesField(decoded, *p.RetryOnBodyMatch) {
+			return details, nil
+		}
+
+		if attempt == maxRetryAttempts {
+			return details, errors.Errorf(errRetriesExhausted, attempt)
+		}
+
+		e.logger.Debug("Backend asked to retry the order", "attempt", attempt, "backoff", backoff)
+		select {
+		case <-ctx.Done():
+			return details, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// escalatedTimeouts returns the timeout of each attempt of a create request.
+func escalatedTimeouts(t v1alpha1.TimeoutEscalation) []time.Duration {
+	factor := defaultTimeoutFactorPercent
+	if t.FactorPercent != nil {
+		factor = *t.FactorPercent
+	}
+	attempts := defaultTimeoutAttempts
+	if t.MaxAttempts != nil {
+		attempts = *t.MaxAttempts
+	}
+
+	timeouts := make([]time.Duration, 0, attempts)
+	timeout := t.Timeout.Duration
+	for i := 0; i < attempts; i++ {
+		if t.MaxTimeout != nil && timeout > t.MaxTimeout.Duration {
+			timeout = t.MaxTimeout.Duration
+		}
+		timeouts = append(timeouts, timeout)
+		timeout = timeout * time.Duration(factor) / 100
+	}
+	return timeouts
+}
+
+// escalateTimeout calls submit with the timeouts of the order's
+// TimeoutEscalation until an attempt does not time out. Without a
+// TimeoutEscalation, submit is called once with ctx.
+func (e *external) escalateTimeout(ctx context.Context, t *v1alpha1.TimeoutEscalation, submit func(context.Context) (httpclient.HttpDetails, error)) (httpclient.HttpDetails, error) {
+	if t == nil {
+		return submit(ctx)
+	}
+
+	var details httpclient.HttpDetails
+	var err error
+	for attempt, timeout := range escalatedTimeouts(*t) {
+		attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+		details, err = submit(attemptCtx)
+		cancel()
+		if !isTimeout(err) || ctx.Err() != nil {
+			return details, err
+		}
+		e.logger.Debug("Create request timed out", "attempt", attempt+1, "timeout", timeout)
+	}
+	return details, err
+}
+
+// isTimeout reports whether err is a request timeout.
+func isTimeout(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var nerr net.Error
+	return errors.As(err, &nerr) && nerr.Timeout()
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+const (
+	retryBody    = `{"status":"retry"}`
+	acceptedBody = `{"orderId":"order-123","status":"pending"}`
+)
+
+// respondInSequence returns the supplied bodies with status code 200 in
+// order, repeating the last one, and counts the requests made.
+func respondInSequence(calls *int, bodies ...string) MockSendRequestFn {
+	return func(_ context.Context, _ string, _ string, _ httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+		body := bodies[len(bodies)-1]
+		if *calls < len(bodies) {
+			body = bodies[*calls]
+		}
+		*calls++
+		return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 200, Body: body}}, nil
+	}
+}
+
+func Test_external_Create_RetryOnBodyMatch(t *testing.T) {
+	retryMatch := &v1alpha1.ResponseFieldMatch{Path: "status", Value: "retry"}
+
+	type want struct {
+		calls int
+		err   error
+	}
+
+	cases := map[string]struct {
+		match  *v1alpha1.ResponseFieldMatch
+		bodies []string
+		want   want
+	}{
+		"NotConfigured": {
+			bodies: []string{acceptedBody},
+			want:   want{calls: 1},
+		},
+		"NoMatch": {
+			match:  retryMatch,
+			bodies: []string{acceptedBody},
+			want:   want{calls: 1},
+		},
+		"RetriedUntilAccepted": {
+			match:  retryMatch,
+			bodies: []string{retryBody, retryBody, acceptedBody},
+			want:   want{calls: 3},
+		},
+		"RetriesExhausted": {
+			match:  retryMatch,
+			bodies: []string{retryBody},
+			want: want{
+				calls: maxRetryAttempts,
+				err:   errors.Wrap(errors.Errorf(errRetriesExhausted, maxRetryAttempts), "failed to create order"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: respondInSequence(&calls, tc.bodies...)},
+				logger: logging.NewNopLogger(),
+			}
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.RetryOnBodyMatch = tc.match
+			})
+
+			_, err := e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.Create(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Fatalf("e.Create(...): -want requests, +got requests: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_escalatedTimeouts(t *testing.T) {
+	cases := map[string]struct {
+		escalation v1alpha1.TimeoutEscalation
+		want       []time.Duration
+	}{
+		"Defaults": {
+			escalation: v1alpha1.TimeoutEscalation{Timeout: metav1.Duration{Duration: 2 * time.Second}},
+			want:       []time.Duration{2 * time.Second, 3 * time.Second, 4500 * time.Millisecond},
+		},
+		"Capped": {
+			escalation: v1alpha1.TimeoutEscalation{
+				Timeout:       metav1.Duration{Duration: time.Second},
+				FactorPercent: ptr.To(200),
+				MaxTimeout:    &metav1.Duration{Duration: 3 * time.Second},
+				MaxAttempts:   ptr.To(4),
+			},
+			want: []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 3 * time.Second},
+		},
+		"SingleAttempt": {
+			escalation: v1alpha1.TimeoutEscalation{Timeout: metav1.Duration{Duration: time.Second}, MaxAttempts: ptr.To(1)},
+			want:       []time.Duration{time.Second},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, escalatedTimeouts(tc.escalation)); diff != "" {
+				t.Fatalf("escalatedTimeouts(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Create_TimeoutEscalation(t *testing.T) {
+	escalation := &v1alpha1.TimeoutEscalation{
+		Timeout:       metav1.Duration{Duration: time.Second},
+		FactorPercent: ptr.To(200),
+		MaxTimeout:    &metav1.Duration{Duration: 3 * time.Second},
+	}
+	timedOut := &url.Error{Op: "Post", URL: testEndpoint, Err: context.DeadlineExceeded}
+
+	type want struct {
+		// timeouts are the timeouts of the attempts of each reconcile.
+		timeouts [][]time.Duration
+		err      error
+	}
+
+	cases := map[string]struct {
+		escalation *v1alpha1.TimeoutEscalation
+		// timeOuts is the number of attempts of each reconcile that time out.
+		timeOuts int
+		want     want
+	}{
+		"NotConfigured": {
+			want: want{timeouts: [][]time.Duration{{0}, {0}}},
+		},
+		"FirstAttemptSucceeds": {
+			escalation: escalation,
+			want:       want{timeouts: [][]time.Duration{{time.Second}, {time.Second}}},
+		},
+		"EscalatedUntilSucceeded": {
+			escalation: escalation,
+			timeOuts:   2,
+			want: want{timeouts: [][]time.Duration{
+				{time.Second, 2 * time.Second, 3 * time.Second},
+				{time.Second, 2 * time.Second, 3 * time.Second},
+			}},
+		},
+		"AttemptsExhausted": {
+			escalation: escalation,
+			timeOuts:   3,
+			want: want{
+				timeouts: [][]time.Duration{
+					{time.Second, 2 * time.Second, 3 * time.Second},
+					{time.Second, 2 * time.Second, 3 * time.Second},
+				},
+				err: errors.Wrap(timedOut, "failed to create order"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			var attempts []time.Duration
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: func(ctx context.Context, _ string, _ string, _ httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+					var timeout time.Duration
+					if deadline, ok := ctx.Deadline(); ok {
+						timeout = time.Until(deadline).Round(100 * time.Millisecond)
+					}
+					attempts = append(attempts, timeout)
+					if len(attempts) <= tc.timeOuts {
+						return httpclient.HttpDetails{}, timedOut
+					}
+					return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 201, Body: acceptedBody}}, nil
+				}},
+				logger: logging.NewNopLogger(),
+			}
+
+			// Each reconcile starts again from the first timeout.
+			for i := 0; i < 2; i++ {
+				attempts = nil
+				cr := portOrder(func(po *v1alpha1.PortOrder) {
+					po.Spec.ForProvider.TimeoutEscalation = tc.escalation
+				})
+				_, got.err = e.Create(context.Background(), cr)
+				got.timeouts = append(got.timeouts, attempts)
+			}
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors(), cmp.AllowUnexported(want{})); diff != "" {
+				t.Fatalf("e.Create(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	"github.com/crossplane-contrib/provider-http/internal/utils"
+)
+
+const (
+	errRolloutStep           = "cannot raise rollout percentage"
+	errRolloutStepStatusCode = "rollout step returned status code %d, body: %s"
+
+	defaultRolloutStep         = 25
+	defaultRolloutStepInterval = 10 * time.Minute
+)
+
+// rolloutUpdate is the request body that raises the rollout percentage of an
+// existing order.
+type rolloutUpdate struct {
+	Order struct {
+		RolloutPercentage int `json:"rolloutPercentage"`
+	} `json:"order"`
+}
+
+// nextRolloutPercentage returns the rollout percentage the order should be
+// raised to at the supplied time. It returns false while the order is not
+// rolled out progressively, is fully rolled out, the backend has not applied
+// the current percentage yet or the step interval has not elapsed.
+func nextRolloutPercentage(cr *v1alpha1.PortOrder, now time.Time) (int, bool) {
+	p := cr.Spec.ForProvider
+	o := cr.Status.AtProvider
+	if p.RolloutPercentage == nil || o.RolloutPercentage == nil || *o.RolloutPercentage >= 100 {
+		return 0, false
+	}
+	if o.AppliedPercentage == nil || *o.AppliedPercentage < *o.RolloutPercentage {
+		return 0, false
+	}
+
+	interval := defaultRolloutStepInterval
+	if p.RolloutStepInterval != nil {
+		interval = p.RolloutStepInterval.Duration
+	}
+	if o.LastRolloutStepTime != nil && now.Before(o.LastRolloutStepTime.Add(interval)) {
+		return 0, false
+	}
+
+	step := defaultRolloutStep
+	if p.RolloutStep != nil {
+		step = *p.RolloutStep
+	}
+	next := *o.RolloutPercentage + step
+	if next > 100 {
+		next = 100
+	}
+	return next, true
+}
+
+// stepRollout requests the supplied rollout percentage for an existing order.
+func (e *external) stepRollout(ctx context.Context, cr *v1alpha1.PortOrder, percentage int) error {
+	endpoint := createEndpoint(cr.Spec.ForProvider)
+	if err := validateEndpoint(endpoint); err != nil {
+		return err
+	}
+
+	var req rolloutUpdate
+	req.Order.RolloutPercentage = percentage
+	body, err := json.Marshal(req)
+	if err != nil {
+		return errors.Wrap(err, errMarshal)
+	}
+
+	details, err := e.patchOrder(ctx, cr, endpoint, cr.Status.AtProvider.OrderID, string(body))
+	if err != nil {
+		return errors.Wrap(err, errRolloutStep)
+	}
+
+	cr.Status.AtProvider.LastResponseStatus = details.HttpResponse.StatusCode
+	if !utils.IsHTTPSuccess(details.HttpResponse.StatusCode) {
+		return errors.Errorf(errRolloutStepStatusCode, details.HttpResponse.StatusCode, details.HttpResponse.Body)
+	}
+
+	now := metav1.Now()
+	cr.Status.AtProvider.RolloutPercentage = &percentage
+	cr.Status.AtProvider.LastRolloutStepTime = &now
+	return nil
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+func withRollout(requested, applied int, stepTime time.Time) portOrderModifier {
+	return func(po *v1alpha1.PortOrder) {
+		po.Spec.ForProvider.RolloutPercentage = ptr.To(10)
+		po.Status.AtProvider.OrderID = testOrderID
+		po.Status.AtProvider.RolloutPercentage = ptr.To(requested)
+		po.Status.AtProvider.AppliedPercentage = ptr.To(applied)
+		po.Status.AtProvider.LastRolloutStepTime = &v1.Time{Time: stepTime}
+	}
+}
+
+func Test_nextRolloutPercentage(t *testing.T) {
+	now := time.Now()
+	due := now.Add(-defaultRolloutStepInterval)
+
+	type want struct {
+		next int
+		ok   bool
+	}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.PortOrder
+		want want
+	}{
+		"NoRollout": {
+			cr: portOrder(),
+		},
+		"Step": {
+			cr:   portOrder(withRollout(10, 10, due)),
+			want: want{next: 35, ok: true},
+		},
+		"CustomStep": {
+			cr: portOrder(withRollout(10, 10, due), func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.RolloutStep = ptr.To(50)
+			}),
+			want: want{next: 60, ok: true},
+		},
+		"CappedAt100": {
+			cr:   portOrder(withRollout(85, 85, due)),
+			want: want{next: 100, ok: true},
+		},
+		"Complete": {
+			cr: portOrder(withRollout(100, 100, due)),
+		},
+		"NotYetApplied": {
+			cr: portOrder(withRollout(35, 10, due)),
+		},
+		"IntervalNotElapsed": {
+			cr: portOrder(withRollout(10, 10, now.Add(-time.Minute))),
+		},
+		"CustomInterval": {
+			cr: portOrder(withRollout(10, 10, now.Add(-time.Minute)), func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.RolloutStepInterval = &v1.Duration{Duration: 30 * time.Second}
+			}),
+			want: want{next: 35, ok: true},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			next, ok := nextRolloutPercentage(tc.cr, now)
+			if diff := cmp.Diff(tc.want, want{next: next, ok: ok}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Fatalf("nextRolloutPercentage(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Update_Rollout(t *testing.T) {
+	var gotMethod, gotURL, gotBody string
+	e := &external{
+		client: &MockHttpClient{
+			MockSendRequest: func(_ context.Context, method string, url string, body httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+				gotMethod, gotURL, gotBody = method, url, body.Decrypted.(string)
+				return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 200}}, nil
+			},
+		},
+		logger: logging.NewNopLogger(),
+	}
+
+	cr := portOrder(withRollout(10, 10, time.Now().Add(-defaultRolloutStepInterval)))
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+
+	if diff := cmp.Diff(http.MethodPatch+" https://api.example.com/orders/"+testOrderID, gotMethod+" "+gotURL); diff != "" {
+		t.Errorf("e.Update(...): -want request, +got request: %s", diff)
+	}
+	if diff := cmp.Diff(`{"order":{"rolloutPercentage":35}}`, gotBody); diff != "" {
+		t.Errorf("e.Update(...): -want body, +got body: %s", diff)
+	}
+	if diff := cmp.Diff(ptr.To(35), cr.Status.AtProvider.RolloutPercentage); diff != "" {
+		t.Errorf("e.Update(...): -want RolloutPercentage, +got RolloutPercentage: %s", diff)
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+const (
+	errGetSchema        = "cannot get request schema ConfigMap %s/%s"
+	errSchemaNoKey      = "request schema ConfigMap %s/%s has no key %q"
+	errSchemaMissing    = "requestSchema must set inline or configMapRef"
+	errCompileSchema    = "cannot compile request schema"
+	errSchemaValidation = "request body does not conform to the request schema"
+
+	// schemaURL names the compiled schema in error messages.
+	schemaURL = "requestSchema.json"
+)
+
+type compiledSchema struct {
+	generation int64
+	schema     *jsonschema.Schema
+}
+
+// schemaCache holds the compiled request schema of each PortOrder for the
+// generation it was compiled for.
+type schemaCache struct {
+	mu      sync.Mutex
+	schemas map[types.UID]compiledSchema
+}
+
+func newSchemaCache() *schemaCache {
+	return &schemaCache{schemas: map[types.UID]compiledSchema{}}
+}
+
+// requestSchema returns the compiled request schema of the order, compiling
+// it unless it was compiled for the current generation of the order.
+func (c *schemaCache) requestSchema(ctx context.Context, kube client.Client, cr *v1alpha1.PortOrder) (*jsonschema.Schema, error) {
+	c.mu.Lock()
+	cached, ok := c.schemas[cr.GetUID()]
+	c.mu.Unlock()
+	if ok && cached.generation == cr.GetGeneration() {
+		return cached.schema, nil
+	}
+
+	doc, err := schemaDocument(ctx, kube, *cr.Spec.ForProvider.RequestSchema)
+	if err != nil {
+		return nil, err
+	}
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(schemaURL, strings.NewReader(doc)); err != nil {
+		return nil, errors.Wrap(err, errCompileSchema)
+	}
+	schema, err := compiler.Compile(schemaURL)
+	if err != nil {
+		return nil, errors.Wrap(err, errCompileSchema)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.schemas[cr.GetUID()] = compiledSchema{generation: cr.GetGeneration(), schema: schema}
+	return schema, nil
+}
+
+// forget drops the compiled request schema of a PortOrder.
+func (c *schemaCache) forget(uid types.UID) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.schemas, uid)
+}
+
+// schemaDocument returns the JSON Schema document of the request schema.
+func schemaDocument(ctx context.Context, kube client.Client, s v1alpha1.RequestSchema) (string, error) {
+	if s.Inline != "" {
+		return s.Inline, nil
+	}
+	ref := s.ConfigMapRef
+	if ref == nil {
+		return "", errors.New(errSchemaMissing)
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+		return "", errors.Wrapf(err, errGetSchema, ref.Namespace, ref.Name)
+	}
+	doc, ok := cm.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf(errSchemaNoKey, ref.Namespace, ref.Name, ref.Key)
+	}
+	return doc, nil
+}
+
+// validateRequest validates the create request body against the request
+// schema of the order, if any.
+func (e *external) validateRequest(ctx context.Context, cr *v1alpha1.PortOrder, body []byte) error {
+	if cr.Spec.ForProvider.RequestSchema == nil {
+		return nil
+	}
+
+	schema, err := e.schemas.requestSchema(ctx, e.kube, cr)
+	if err != nil {
+		return err
+	}
+
+	var v interface{}
+	if err := decodeJSON(string(bytes.TrimSpace(body)), &v); err != nil {
+		return errors.Wrap(err, errUnmarshal)
+	}
+	if err := schema.Validate(v); err != nil {
+		return errors.Wrap(err, errSchemaValidation)
+	}
+	return nil
+}
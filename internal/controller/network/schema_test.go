@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+const testRequestSchema = `{
+	"type": "object",
+	"required": ["order"],
+	"properties": {
+		"order": {
+			"type": "object",
+			"properties": {"ports": {"type": "array", "maxItems": 1}}
+		}
+	}
+}`
+
+func withRequestSchema(s v1alpha1.RequestSchema) func(*v1alpha1.PortOrder) {
+	return func(po *v1alpha1.PortOrder) {
+		po.Spec.ForProvider.RequestSchema = &s
+	}
+}
+
+func Test_external_validateRequest(t *testing.T) {
+	ref := &v1alpha1.ConfigMapKeySelector{Namespace: "crossplane-system", Name: "schemas", Key: "orders.json"}
+
+	cases := map[string]struct {
+		cr      *v1alpha1.PortOrder
+		get     test.MockGetFn
+		body    string
+		wantErr string
+	}{
+		"NoSchema": {
+			cr:   portOrder(),
+			body: `{}`,
+		},
+		"InlineConforms": {
+			cr:   portOrder(withRequestSchema(v1alpha1.RequestSchema{Inline: testRequestSchema})),
+			body: `{"order":{"ports":[{"protocol":"TCP","port":443}]}}`,
+		},
+		"InlineViolated": {
+			cr:      portOrder(withRequestSchema(v1alpha1.RequestSchema{Inline: testRequestSchema})),
+			body:    `{"order":{"ports":[{"port":443},{"port":80}]}}`,
+			wantErr: errSchemaValidation,
+		},
+		"ConfigMap": {
+			cr: portOrder(withRequestSchema(v1alpha1.RequestSchema{ConfigMapRef: ref})),
+			get: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+				obj.(*corev1.ConfigMap).Data = map[string]string{"orders.json": testRequestSchema}
+				return nil
+			},
+			body:    `{}`,
+			wantErr: errSchemaValidation,
+		},
+		"ConfigMapKeyMissing": {
+			cr:      portOrder(withRequestSchema(v1alpha1.RequestSchema{ConfigMapRef: ref})),
+			get:     test.NewMockGetFn(nil),
+			body:    `{}`,
+			wantErr: errors.Errorf(errSchemaNoKey, "crossplane-system", "schemas", "orders.json").Error(),
+		},
+		"SchemaInvalid": {
+			cr:      portOrder(withRequestSchema(v1alpha1.RequestSchema{Inline: `{"type": 7}`})),
+			body:    `{}`,
+			wantErr: errCompileSchema,
+		},
+		"SchemaMissing": {
+			cr:      portOrder(withRequestSchema(v1alpha1.RequestSchema{})),
+			body:    `{}`,
+			wantErr: errSchemaMissing,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: &test.MockClient{MockGet: tc.get}, schemas: newSchemaCache()}
+			err := e.validateRequest(context.Background(), tc.cr, []byte(tc.body))
+			if tc.wantErr == "" && err != nil {
+				t.Fatalf("e.validateRequest(...): unexpected error: %s", err)
+			}
+			if tc.wantErr != "" && (err == nil || !strings.HasPrefix(err.Error(), tc.wantErr)) {
+				t.Fatalf("e.validateRequest(...): want error starting with %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func Test_schemaCache(t *testing.T) {
+	var gets int
+	kube := &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		gets++
+		obj.(*corev1.ConfigMap).Data = map[string]string{"orders.json": testRequestSchema}
+		return nil
+	}}
+	cr := portOrder(withRequestSchema(v1alpha1.RequestSchema{
+		ConfigMapRef: &v1alpha1.ConfigMapKeySelector{Namespace: "crossplane-system", Name: "schemas", Key: "orders.json"},
+	}))
+	c := newSchemaCache()
+
+	for _, generation := range []int64{1, 1, 2} {
+		cr.SetGeneration(generation)
+		if _, err := c.requestSchema(context.Background(), kube, cr); err != nil {
+			t.Fatalf("c.requestSchema(...): %v", err)
+		}
+	}
+	if diff := cmp.Diff(2, gets); diff != "" {
+		t.Fatalf("c.requestSchema(...): -want ConfigMap reads, +got ConfigMap reads: %s", diff)
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"strings"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+const (
+	defaultFailedPollInterval = time.Hour
+
+	// Pending orders are polled with this backoff by default.
+	defaultPendingPollBase   = 30 * time.Second
+	defaultPendingPollFactor = 2
+	defaultPendingPollCap    = 30 * time.Minute
+
+	// Poll intervals requested by the backend are clamped to this range.
+	minBackendPollInterval = 10 * time.Second
+	maxBackendPollInterval = time.Hour
+)
+
+var (
+	defaultReadyStatuses  = []string{"active", "complete"}
+	defaultFailedStatuses = []string{"failed", "rejected", "error"}
+	// defaultDeletedStatuses are the statuses of an order the backend has
+	// removed.
+	defaultDeletedStatuses = []string{"cancelled", "canceled", "deleted"}
+)
+
+// hasStatus reports whether status is one of statuses, ignoring case.
+func hasStatus(statuses []string, status string) bool {
+	for _, s := range statuses {
+		if strings.EqualFold(s, status) {
+			return true
+		}
+	}
+	return false
+}
+
+// readyStatuses returns the statuses of an order that has been applied
+// successfully.
+func readyStatuses(p v1alpha1.PortOrderParameters) []string {
+	if len(p.ReadyStatuses) == 0 {
+		return defaultReadyStatuses
+	}
+	return p.ReadyStatuses
+}
+
+// isReady reports whether the order has been applied successfully, as last
+// reported by its readiness endpoint if it has one, and by its status
+// otherwise.
+func isReady(cr *v1alpha1.PortOrder) bool {
+	if probesReadiness(cr.Spec.ForProvider) {
+		return cr.Status.AtProvider.ReadinessProbeSucceeded && !isFailed(cr)
+	}
+	return hasStatus(readyStatuses(cr.Spec.ForProvider), cr.Status.AtProvider.Status)
+}
+
+// isFailed reports whether the order is in a terminal failed status.
+func isFailed(cr *v1alpha1.PortOrder) bool {
+	statuses := cr.Spec.ForProvider.FailedStatuses
+	if len(statuses) == 0 {
+		statuses = defaultFailedStatuses
+	}
+	return hasStatus(statuses, cr.Status.AtProvider.Status)
+}
+
+// WithPollIntervalHook returns a managed.ReconcilerOption that polls orders
+// at the interval requested by the backend, if any, pending orders with their
+// PendingPollBackoff and orders in a terminal failed status at their
+// FailedPollInterval instead of the regular poll interval.
+func WithPollIntervalHook() managed.ReconcilerOption {
+	return managed.WithPollIntervalHook(pollIntervalHook)
+}
+
+func pollIntervalHook(mg resource.Managed, pollInterval time.Duration) time.Duration {
+	d, ok := backendPollInterval(mg)
+	if !ok {
+		d, ok = pendingPollInterval(mg)
+	}
+	if !ok {
+		d = failedPollInterval(mg, pollInterval)
+	}
+	return expiryPollInterval(mg, d, time.Now())
+}
+
+// expiryPollInterval shortens the poll interval of an order that expires
+// before it, so that it is cancelled on time.
+func expiryPollInterval(mg resource.Managed, pollInterval time.Duration, now time.Time) time.Duration {
+	cr, ok := mg.(*v1alpha1.PortOrder)
+	if !ok {
+		return pollInterval
+	}
+	left, ok := untilExpiry(cr, now)
+	if !ok || left >= pollInterval {
+		return pollInterval
+	}
+	if left < minExpiryPollInterval {
+		return minExpiryPollInterval
+	}
+	return left
+}
+
+// backendPollInterval returns the poll interval last requested by the
+// backend, clamped to a sane range.
+func backendPollInterval(mg resource.Managed) (time.Duration, bool) {
+	cr, ok := mg.(*v1alpha1.PortOrder)
+	if !ok || cr.Status.AtProvider.PollAfterSeconds == nil {
+		return 0, false
+	}
+
+	// Clamp in seconds so that absurd values cannot overflow.
+	switch secs := *cr.Status.AtProvider.PollAfterSeconds; {
+	case secs < int64(minBackendPollInterval/time.Second):
+		return minBackendPollInterval, true
+	case secs > int64(maxBackendPollInterval/time.Second):
+		return maxBackendPollInterval, true
+	default:
+		return time.Duration(secs) * time.Second, true
+	}
+}
+
+// pendingPollInterval returns the poll interval of an order that is neither
+// ready nor failed, backed off exponentially by the number of observations
+// since its status last changed.
+func pendingPollInterval(mg resource.Managed) (time.Duration, bool) {
+	cr, ok := mg.(*v1alpha1.PortOrder)
+	if !ok || cr.Spec.ForProvider.PendingPollBackoff == nil || cr.Status.AtProvider.OrderID == "" || isReady(cr) || isFailed(cr) {
+		return 0, false
+	}
+
+	b := cr.Spec.ForProvider.PendingPollBackoff
+	base, factor, maxInterval := defaultPendingPollBase, int64(defaultPendingPollFactor), defaultPendingPollCap
+	if b.Base != nil {
+		base = b.Base.Duration
+	}
+	if b.Factor != nil {
+		factor = *b.Factor
+	}
+	if b.Cap != nil {
+		maxInterval = b.Cap.Duration
+	}
+
+	// Multiply step by step, stopping at the cap, so that neither many
+	// observations nor a large factor can overflow.
+	d := base
+	for i := 0; i < cr.Status.AtProvider.PollsInStatus && d < maxInterval && factor > 1; i++ {
+		if d > maxInterval/time.Duration(factor) {
+			d = maxInterval
+			break
+		}
+		d *= time.Duration(factor)
+	}
+	if d > maxInterval {
+		return maxInterval, true
+	}
+	return d, true
+}
+
+func failedPollInterval(mg resource.Managed, pollInterval time.Duration) time.Duration {
+	cr, ok := mg.(*v1alpha1.PortOrder)
+	if !ok || !isFailed(cr) {
+		return pollInterval
+	}
+
+	if cr.Spec.ForProvider.FailedPollInterval != nil {
+		return cr.Spec.ForProvider.FailedPollInterval.Duration
+	}
+	return defaultFailedPollInterval
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+func Test_failedPollInterval(t *testing.T) {
+	pollInterval := time.Minute
+
+	cases := map[string]struct {
+		status         string
+		failedStatuses []string
+		interval       *v1.Duration
+		want           time.Duration
+	}{
+		"Pending": {
+			status: "pending",
+			want:   pollInterval,
+		},
+		"DefaultFailedStatus": {
+			status: "Rejected",
+			want:   defaultFailedPollInterval,
+		},
+		"CustomFailedStatus": {
+			status:         "denied",
+			failedStatuses: []string{"denied"},
+			want:           defaultFailedPollInterval,
+		},
+		"CustomFailedStatusesReplaceDefaults": {
+			status:         "failed",
+			failedStatuses: []string{"denied"},
+			want:           pollInterval,
+		},
+		"CustomInterval": {
+			status:   "failed",
+			interval: &v1.Duration{Duration: 6 * time.Hour},
+			want:     6 * time.Hour,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.Status = tc.status
+				po.Spec.ForProvider.FailedStatuses = tc.failedStatuses
+				po.Spec.ForProvider.FailedPollInterval = tc.interval
+			})
+			if diff := cmp.Diff(tc.want, failedPollInterval(cr, pollInterval)); diff != "" {
+				t.Fatalf("failedPollInterval(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_pollIntervalHook(t *testing.T) {
+	pollInterval := time.Minute
+
+	backoff := &v1alpha1.PollBackoff{
+		Base:   &v1.Duration{Duration: 10 * time.Second},
+		Factor: ptr.To[int64](3),
+		Cap:    &v1.Duration{Duration: 5 * time.Minute},
+	}
+
+	cases := map[string]struct {
+		status    string
+		pollAfter *int64
+		backoff   *v1alpha1.PollBackoff
+		polls     int
+		want      time.Duration
+	}{
+		"NoBackendInterval": {
+			want: pollInterval,
+		},
+		"BackendInterval": {
+			pollAfter: ptr.To[int64](300),
+			want:      5 * time.Minute,
+		},
+		"BackendIntervalOverridesFailed": {
+			status:    "failed",
+			pollAfter: ptr.To[int64](120),
+			want:      2 * time.Minute,
+		},
+		"ClampedToMinimum": {
+			pollAfter: ptr.To[int64](0),
+			want:      minBackendPollInterval,
+		},
+		"ClampedToMaximum": {
+			pollAfter: ptr.To[int64](1 << 62),
+			want:      maxBackendPollInterval,
+		},
+		"FailedWithoutBackendInterval": {
+			status: "failed",
+			want:   defaultFailedPollInterval,
+		},
+		"PendingBackoffStatusChanged": {
+			status:  "pending",
+			backoff: backoff,
+			want:    10 * time.Second,
+		},
+		"PendingBackoff": {
+			status:  "pending",
+			backoff: backoff,
+			polls:   2,
+			want:    90 * time.Second,
+		},
+		"PendingBackoffCapped": {
+			status:  "pending",
+			backoff: backoff,
+			polls:   1000,
+			want:    5 * time.Minute,
+		},
+		"PendingBackoffLargeFactor": {
+			status:  "pending",
+			backoff: &v1alpha1.PollBackoff{Factor: ptr.To[int64](1 << 62)},
+			polls:   3,
+			want:    defaultPendingPollCap,
+		},
+		"PendingBackoffDefaults": {
+			status:  "pending",
+			backoff: &v1alpha1.PollBackoff{},
+			polls:   3,
+			want:    4 * time.Minute,
+		},
+		"PendingBackoffBackendInterval": {
+			status:    "pending",
+			pollAfter: ptr.To[int64](120),
+			backoff:   backoff,
+			polls:     3,
+			want:      2 * time.Minute,
+		},
+		"ReadyNotBackedOff": {
+			status:  "active",
+			backoff: backoff,
+			polls:   3,
+			want:    pollInterval,
+		},
+		"FailedNotBackedOff": {
+			status:  "failed",
+			backoff: backoff,
+			polls:   3,
+			want:    defaultFailedPollInterval,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.Status = tc.status
+				po.Status.AtProvider.PollAfterSeconds = tc.pollAfter
+				po.Status.AtProvider.OrderID = testOrderID
+				po.Status.AtProvider.PollsInStatus = tc.polls
+				po.Spec.ForProvider.PendingPollBackoff = tc.backoff
+			})
+			got := pollIntervalHook(cr, pollInterval)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Fatalf("pollIntervalHook(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	"github.com/crossplane-contrib/provider-http/internal/utils"
+)
+
+// terminalStatusError is returned for responses whose status code is
+// configured as terminal.
+type terminalStatusError struct {
+	code int
+	body string
+}
+
+func (e *terminalStatusError) Error() string {
+	return fmt.Sprintf("terminal status code: %d, body: %s", e.code, e.body)
+}
+
+// statusAction returns how a response status code to a create, read or
+// cancellation of the order is handled. The first configured action whose
+// codes match applies, falling back to the default action.
+func statusAction(p v1alpha1.PortOrderParameters, create bool, code int) string {
+	for _, a := range p.StatusCodeActions {
+		if matchesCodes(a.Codes, code) {
+			return a.Action
+		}
+	}
+
+	switch {
+	case create && (code == http.StatusOK || code == http.StatusCreated):
+		return v1alpha1.StatusCodeActionSuccess
+	case !create && utils.IsHTTPSuccess(code):
+		return v1alpha1.StatusCodeActionSuccess
+	case !create && code == http.StatusNotFound:
+		return v1alpha1.StatusCodeActionNotExists
+	default:
+		return v1alpha1.StatusCodeActionRetry
+	}
+}
+
+// matchesCodes reports whether the status code is one of the codes, given as
+// a single code, an inclusive range such as "500-504" or a class such as
+// "4xx". Malformed codes match nothing.
+func matchesCodes(codes string, code int) bool {
+	if class, ok := strings.CutSuffix(codes, "xx"); ok {
+		c, err := strconv.Atoi(class)
+		return err == nil && code/100 == c
+	}
+	from, to, isRange := strings.Cut(codes, "-")
+	if !isRange {
+		to = from
+	}
+	low, err := strconv.Atoi(from)
+	if err != nil {
+		return false
+	}
+	high, err := strconv.Atoi(to)
+	if err != nil {
+		return false
+	}
+	return code >= low && code <= high
+}
+
+// rejectedObservation returns the observation of an order the orders API
+// rejected with a terminal status code, if err reports one. The order is
+// reported as existing, so that it is neither created again nor fails every
+// observation.
+func rejectedObservation(cr *v1alpha1.PortOrder, err error) (managed.ExternalObservation, bool) {
+	var terr *terminalStatusError
+	if !errors.As(err, &terr) {
+		return managed.ExternalObservation{}, false
+	}
+	cr.SetConditions(v1alpha1.OrderRejected(terr.code))
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, true
+}
+
+// notSubmitted records that the current generation of the order was not
+// submitted for a reason no retry fixes, returning err. Like an order the
+// backend rejected, it is not submitted again until its spec changes.
+func notSubmitted(cr *v1alpha1.PortOrder, err error) error {
+	cr.Status.AtProvider.StatusReason = err.Error()
+	cr.Status.AtProvider.LastResponseStatus = 0
+	cr.Status.AtProvider.RejectedGeneration = cr.GetGeneration()
+	cr.Status.AtProvider.QueueState = ""
+	cr.SetConditions(v1alpha1.OrderNotSubmitted(err.Error()))
+	return err
+}
+
+// rejected reports whether the backend rejected the create of the current
+// generation of the order with a terminal status code, or whether it was not
+// submitted at all.
+func rejected(cr *v1alpha1.PortOrder) bool {
+	g := cr.Status.AtProvider.RejectedGeneration
+	return g != 0 && g == cr.GetGeneration()
+}
@@ -0,0 +1,339 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+func Test_statusAction(t *testing.T) {
+	custom := []v1alpha1.StatusCodeAction{
+		{Codes: "422", Action: v1alpha1.StatusCodeActionTerminal},
+		{Codes: "503", Action: v1alpha1.StatusCodeActionRetry},
+		{Codes: "500-504", Action: v1alpha1.StatusCodeActionTerminal},
+		{Codes: "2xx", Action: v1alpha1.StatusCodeActionSuccess},
+		{Codes: "410", Action: v1alpha1.StatusCodeActionNotExists},
+	}
+
+	cases := map[string]struct {
+		actions []v1alpha1.StatusCodeAction
+		create  bool
+		code    int
+		want    string
+	}{
+		"DefaultCreateCreated": {
+			create: true,
+			code:   201,
+			want:   v1alpha1.StatusCodeActionSuccess,
+		},
+		"DefaultCreateAccepted": {
+			create: true,
+			code:   202,
+			want:   v1alpha1.StatusCodeActionRetry,
+		},
+		"DefaultCreateNotFound": {
+			create: true,
+			code:   404,
+			want:   v1alpha1.StatusCodeActionRetry,
+		},
+		"DefaultReadNoContent": {
+			code: 204,
+			want: v1alpha1.StatusCodeActionSuccess,
+		},
+		"DefaultReadNotFound": {
+			code: 404,
+			want: v1alpha1.StatusCodeActionNotExists,
+		},
+		"DefaultReadUnprocessable": {
+			code: 422,
+			want: v1alpha1.StatusCodeActionRetry,
+		},
+		"CustomCode": {
+			actions: custom,
+			code:    422,
+			want:    v1alpha1.StatusCodeActionTerminal,
+		},
+		"CustomFirstMatchApplies": {
+			actions: custom,
+			code:    503,
+			want:    v1alpha1.StatusCodeActionRetry,
+		},
+		"CustomRange": {
+			actions: custom,
+			code:    504,
+			want:    v1alpha1.StatusCodeActionTerminal,
+		},
+		"CustomClass": {
+			actions: custom,
+			create:  true,
+			code:    202,
+			want:    v1alpha1.StatusCodeActionSuccess,
+		},
+		"CustomNotExists": {
+			actions: custom,
+			code:    410,
+			want:    v1alpha1.StatusCodeActionNotExists,
+		},
+		"CustomFallsBackToDefault": {
+			actions: custom,
+			code:    404,
+			want:    v1alpha1.StatusCodeActionNotExists,
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got := statusAction(v1alpha1.PortOrderParameters{StatusCodeActions: tc.actions}, tc.create, tc.code)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Fatalf("statusAction(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_matchesCodes(t *testing.T) {
+	cases := map[string]struct {
+		codes string
+		code  int
+		want  bool
+	}{
+		"Code":           {codes: "422", code: 422, want: true},
+		"OtherCode":      {codes: "422", code: 423},
+		"RangeLow":       {codes: "500-504", code: 500, want: true},
+		"RangeHigh":      {codes: "500-504", code: 504, want: true},
+		"OutsideRange":   {codes: "500-504", code: 505},
+		"Class":          {codes: "4xx", code: 499, want: true},
+		"OtherClass":     {codes: "4xx", code: 500},
+		"Malformed":      {codes: "abc", code: 500},
+		"MalformedRange": {codes: "500-", code: 500},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, matchesCodes(tc.codes, tc.code)); diff != "" {
+				t.Fatalf("matchesCodes(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func withTerminalCode(codes string) portOrderModifier {
+	return func(po *v1alpha1.PortOrder) {
+		po.Spec.ForProvider.StatusCodeActions = []v1alpha1.StatusCodeAction{
+			{Codes: codes, Action: v1alpha1.StatusCodeActionTerminal},
+		}
+	}
+}
+
+func withGeneration(g int64) portOrderModifier {
+	return func(po *v1alpha1.PortOrder) {
+		po.SetGeneration(g)
+	}
+}
+
+func Test_external_Create_TerminalStatus(t *testing.T) {
+	type want struct {
+		err       error
+		rejected  int64
+		condition xpv1.Condition
+	}
+
+	body := `{"reason":"port 22 is not allowed"}`
+
+	cases := map[string]struct {
+		cr   *v1alpha1.PortOrder
+		want want
+	}{
+		"Terminal": {
+			cr: portOrder(withTerminalCode("422"), withGeneration(3)),
+			want: want{
+				err:       &terminalStatusError{code: 422, body: body},
+				rejected:  3,
+				condition: v1alpha1.OrderRejected(422),
+			},
+		},
+		"Retried": {
+			cr: portOrder(withTerminalCode("400"), withGeneration(3)),
+			want: want{
+				err:       errors.New(`unexpected status code: 422, body: {"reason":"port 22 is not allowed"}`),
+				condition: xpv1.Condition{Type: xpv1.TypeReady, Status: "Unknown"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: respondWith(422, body)},
+				logger: logging.NewNopLogger(),
+			}
+
+			_, err := e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.Create(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.rejected, tc.cr.Status.AtProvider.RejectedGeneration); diff != "" {
+				t.Errorf("e.Create(...): -want RejectedGeneration, +got RejectedGeneration: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, tc.cr.GetCondition(xpv1.TypeReady), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("e.Create(...): -want Ready condition, +got Ready condition: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Observe_TerminalStatus(t *testing.T) {
+	type want struct {
+		obs       managed.ExternalObservation
+		err       error
+		condition xpv1.Condition
+	}
+
+	rejectedAt := func(g int64) portOrderModifier {
+		return func(po *v1alpha1.PortOrder) {
+			po.Status.AtProvider.RejectedGeneration = g
+			po.Status.AtProvider.LastResponseStatus = 422
+		}
+	}
+	withOrder := func(po *v1alpha1.PortOrder) {
+		po.Status.AtProvider.OrderID = testOrderID
+	}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.PortOrder
+		want want
+	}{
+		"CreateRejected": {
+			cr: portOrder(withGeneration(3), rejectedAt(3)),
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: v1alpha1.OrderRejected(422),
+			},
+		},
+		"NotSubmitted": {
+			cr: portOrder(withGeneration(3), func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.RejectedGeneration = 3
+				po.Status.AtProvider.StatusReason = "order exceeds the quota"
+			}),
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: v1alpha1.OrderNotSubmitted("order exceeds the quota"),
+			},
+		},
+		"SpecChangedSinceRejection": {
+			cr: portOrder(withGeneration(4), rejectedAt(3)),
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: false},
+				condition: xpv1.Condition{Type: xpv1.TypeReady, Status: "Unknown"},
+			},
+		},
+		"ReadTerminal": {
+			cr: portOrder(withOrder, withTerminalCode("4xx")),
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: v1alpha1.OrderRejected(422),
+			},
+		},
+		"ReadRetried": {
+			cr: portOrder(withOrder),
+			want: want{
+				err:       errors.New("unexpected status code: 422, body: "),
+				condition: xpv1.Condition{Type: xpv1.TypeReady, Status: "Unknown"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: respondWith(422, "")},
+				logger: logging.NewNopLogger(),
+			}
+
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.Observe(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("e.Observe(...): -want, +got: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, tc.cr.GetCondition(xpv1.TypeReady), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("e.Observe(...): -want Ready condition, +got Ready condition: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Delete_StatusCodeActions(t *testing.T) {
+	cases := map[string]struct {
+		actions []v1alpha1.StatusCodeAction
+		code    int
+		want    error
+	}{
+		"DefaultNotFound": {
+			code: 404,
+		},
+		"DefaultGone": {
+			code: 410,
+			want: errors.Errorf(errCancelStatusCode, 410, ""),
+		},
+		"GoneNotExists": {
+			actions: []v1alpha1.StatusCodeAction{{Codes: "410", Action: v1alpha1.StatusCodeActionNotExists}},
+			code:    410,
+		},
+		"TerminalRetried": {
+			actions: []v1alpha1.StatusCodeAction{{Codes: "4xx", Action: v1alpha1.StatusCodeActionTerminal}},
+			code:    409,
+			want:    errors.Errorf(errCancelStatusCode, 409, ""),
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: respondWith(tc.code, "")},
+				logger: logging.NewNopLogger(),
+			}
+			cr := portOrder(func(po *v1alpha1.PortOrder) {
+				po.Status.AtProvider.OrderID = testOrderID
+				po.Spec.ForProvider.StatusCodeActions = tc.actions
+			})
+
+			err := e.Delete(context.Background(), cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.Delete(...): -want error, +got error: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,314 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+const (
+	errStreamStatusCode = "status stream returned status code %d"
+	errNoStreamer       = "HTTP client cannot open status streams"
+
+	streamInitialBackoff = time.Second
+	streamMaxBackoff     = time.Minute
+)
+
+// streamRequest describes how to open the status stream of an order.
+type streamRequest struct {
+	url string
+	// prepare returns the URL and headers of each connection attempt to url,
+	// with the credentials and signatures of any other request applied, so
+	// that refreshed credentials are picked up on reconnect.
+	prepare func(ctx context.Context, url string) (string, httpclient.Data, error)
+	// client opens the stream with the options of the client of the order.
+	client httpclient.Streamer
+	// websocket subscribes to the updates of orderID over a WebSocket
+	// instead of reading server-sent events.
+	websocket bool
+	orderID   string
+}
+
+// streamKey identifies what a stream follows. A stream is restarted when the
+// key of its order changes.
+type streamKey struct {
+	url       string
+	orderID   string
+	websocket bool
+}
+
+func (r streamRequest) key() streamKey {
+	return streamKey{url: r.url, orderID: r.orderID, websocket: r.websocket}
+}
+
+// stream is a running status stream consumer.
+type stream struct {
+	key    streamKey
+	cancel context.CancelFunc
+}
+
+// streamManager runs one status stream consumer per PortOrder and patches the
+// order status as events arrive.
+type streamManager struct {
+	kube     client.Client
+	logger   logging.Logger
+	redactor *redactor
+
+	mu      sync.Mutex
+	streams map[types.UID]*stream
+	// connected holds the orders whose stream is currently connected.
+	connected map[types.UID]bool
+}
+
+func newStreamManager(kube client.Client, logger logging.Logger, redactor *redactor) *streamManager {
+	return &streamManager{
+		kube:      kube,
+		logger:    logger,
+		redactor:  redactor,
+		streams:   map[types.UID]*stream{},
+		connected: map[types.UID]bool{},
+	}
+}
+
+// Connected reports whether the status stream of the order is connected.
+func (m *streamManager) Connected(uid types.UID) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.connected[uid]
+}
+
+// setConnected records whether the stream of the order is connected, unless
+// it was replaced by another one.
+func (m *streamManager) setConnected(uid types.UID, s *stream, connected bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.streams[uid] != s {
+		return
+	}
+	if connected {
+		m.connected[uid] = true
+		return
+	}
+	delete(m.connected, uid)
+}
+
+// Ensure starts consuming the status stream of the order unless it is
+// already being consumed. A stream that follows another URL or order ID, e.g.
+// because the order was created again, or that is consumed in another mode is
+// replaced.
+func (m *streamManager) Ensure(cr *v1alpha1.PortOrder, req streamRequest) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	uid := cr.GetUID()
+	if s, ok := m.streams[uid]; ok {
+		if s.key == req.key() {
+			return
+		}
+		s.cancel()
+		delete(m.connected, uid)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &stream{key: req.key(), cancel: cancel}
+	m.streams[uid] = s
+
+	go m.run(ctx, uid, cr.GetName(), req, s)
+}
+
+// Stop stops consuming the status stream of the order, if any.
+func (m *streamManager) Stop(uid types.UID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if s, ok := m.streams[uid]; ok {
+		s.cancel()
+		delete(m.streams, uid)
+	}
+	delete(m.connected, uid)
+}
+
+// release stops the stream of the order once its consumer exits, unless it
+// was replaced by another one.
+func (m *streamManager) release(uid types.UID, s *stream) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s.cancel()
+	if m.streams[uid] == s {
+		delete(m.streams, uid)
+		delete(m.connected, uid)
+	}
+}
+
+func (m *streamManager) run(ctx context.Context, uid types.UID, name string, req streamRequest, s *stream) {
+	defer m.release(uid, s)
+
+	l := m.logger.WithValues("portOrder", name)
+	backoff := streamInitialBackoff
+
+	for {
+		err := m.consume(ctx, name, req, func() {
+			backoff = streamInitialBackoff
+			m.setConnected(uid, s, true)
+		})
+		m.setConnected(uid, s, false)
+		if ctx.Err() != nil {
+			return
+		}
+		if kerrors.IsNotFound(errors.Cause(err)) {
+			l.Debug("PortOrder is gone, stopping status stream")
+			return
+		}
+		l.Debug("Status stream disconnected, reconnecting", "error", err, "backoff", backoff)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > streamMaxBackoff {
+			backoff = streamMaxBackoff
+		}
+	}
+}
+
+// consume opens the stream once and applies its events until it ends.
+func (m *streamManager) consume(ctx context.Context, name string, req streamRequest, connected func()) error {
+	url, headers, err := req.prepare(ctx, req.url)
+	if err != nil {
+		return err
+	}
+
+	if req.websocket {
+		return m.consumeWebSocket(ctx, name, req, url, headers, connected)
+	}
+
+	resp, err := req.client.Stream(ctx, http.MethodGet, url, headers, false)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() //nolint:errcheck // nothing to do about a failed close.
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf(errStreamStatusCode, resp.StatusCode)
+	}
+	connected()
+
+	return readEvents(resp.Body, func(data string) error {
+		return m.apply(ctx, name, data)
+	})
+}
+
+// apply patches the order status with a single stream event. Events are read
+// like responses to reads of the order, so the status is found at the
+// StatusPath of the order.
+func (m *streamManager) apply(ctx context.Context, name, data string) error {
+	cr := &v1alpha1.PortOrder{}
+	if err := m.kube.Get(ctx, types.NamespacedName{Name: name}, cr); err != nil {
+		return err
+	}
+	if cr.GetDeletionTimestamp() != nil {
+		return kerrors.NewNotFound(v1alpha1.SchemeGroupVersion.WithResource("portorders").GroupResource(), name)
+	}
+
+	var event OrderResponse
+	if err := decodeOrder(cr.Spec.ForProvider, data, &event); err != nil {
+		m.logger.Debug("Ignoring malformed status event", "portOrder", name, "error", err)
+		return nil
+	}
+	if event.Status == "" {
+		return nil
+	}
+
+	o := &cr.Status.AtProvider
+	changed := o.Status != event.Status
+	o.Status = event.Status
+	if event.AppliedPercentage != nil && (o.AppliedPercentage == nil || *o.AppliedPercentage != *event.AppliedPercentage) {
+		o.AppliedPercentage = event.AppliedPercentage
+		changed = true
+	}
+	if t := lastModified(event.LastModified); t != nil && !t.Equal(o.LastModifiedByBackend) {
+		o.LastModifiedByBackend = t
+		changed = true
+	}
+	if reason := m.redactor.redact(event.Reason); o.StatusReason != reason {
+		o.StatusReason = reason
+		changed = true
+	}
+	if event.AffectedDevices != 0 && o.AffectedDevices != event.AffectedDevices {
+		o.AffectedDevices = event.AffectedDevices
+		changed = true
+	}
+	if event.Zone != "" && o.Zone != event.Zone {
+		o.Zone = event.Zone
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+
+	if err := m.kube.Status().Update(ctx, cr); err != nil {
+		// A conflicting update is superseded by the next event or poll.
+		m.logger.Debug("Cannot update status from stream event", "portOrder", name, "error", err)
+	}
+	return nil
+}
+
+// readEvents calls fn with the data of every server-sent event read from r.
+func readEvents(r io.Reader, fn func(data string) error) error {
+	scanner := bufio.NewScanner(r)
+	var data []string
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if len(data) > 0 {
+				if err := fn(strings.Join(data, "\n")); err != nil {
+					return err
+				}
+				data = nil
+			}
+		case strings.HasPrefix(line, "data:"):
+			data = append(data, strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return io.EOF
+}
@@ -0,0 +1,366 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+// testStreamer returns an HTTP client opening status streams.
+func testStreamer(t *testing.T, opts ...httpclient.ClientOption) httpclient.Streamer {
+	t.Helper()
+	c, err := httpclient.NewClient(logging.NewNopLogger(), time.Second, "", opts...)
+	if err != nil {
+		t.Fatalf("httpclient.NewClient(...): %v", err)
+	}
+	return c.(httpclient.Streamer)
+}
+
+// withStreamHeaders prepares stream connections with the supplied headers.
+func withStreamHeaders(headers map[string][]string) func(context.Context, string) (string, httpclient.Data, error) {
+	if headers == nil {
+		headers = map[string][]string{}
+	}
+	return func(_ context.Context, url string) (string, httpclient.Data, error) {
+		return url, httpclient.Data{Encrypted: headers, Decrypted: headers}, nil
+	}
+}
+
+func Test_readEvents(t *testing.T) {
+	stream := strings.Join([]string{
+		": keep-alive",
+		"event: status",
+		`data: {"status":"pending"}`,
+		"",
+		`data: {"status":`,
+		`data: "active"}`,
+		"",
+		`data: {"status":"incomplete"}`,
+	}, "\n")
+
+	var got []string
+	err := readEvents(strings.NewReader(stream), func(data string) error {
+		got = append(got, data)
+		return nil
+	})
+	if err != io.EOF {
+		t.Fatalf("readEvents(...): want io.EOF, got %v", err)
+	}
+
+	want := []string{`{"status":"pending"}`, "{\"status\":\n\"active\"}"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("readEvents(...): -want, +got: %s", diff)
+	}
+}
+
+func Test_streamManager(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(authKey) != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = io.WriteString(w, "data: {\"status\":\"active\"}\n\n")
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer s.Close()
+
+	updated := make(chan string, 1)
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			portOrder().DeepCopyInto(obj.(*v1alpha1.PortOrder))
+			return nil
+		},
+		MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+			updated <- obj.(*v1alpha1.PortOrder).Status.AtProvider.Status
+			return nil
+		},
+	}
+
+	m := newStreamManager(kube, logging.NewNopLogger(), nil)
+	cr := portOrder()
+	m.Ensure(cr, streamRequest{
+		url:     s.URL,
+		prepare: withStreamHeaders(map[string][]string{authKey: {"token"}}),
+		client:  testStreamer(t),
+	})
+	defer m.Stop(cr.GetUID())
+
+	select {
+	case got := <-updated:
+		if got != "active" {
+			t.Fatalf("stream status update: want active, got %s", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream status update: timed out")
+	}
+}
+
+func Test_streamManager_WebSocket(t *testing.T) {
+	s := httptest.NewServer(websocket.Handler(func(ws *websocket.Conn) {
+		var sub webSocketSubscription
+		if err := websocket.JSON.Receive(ws, &sub); err != nil || sub.OrderID != testOrderID {
+			return
+		}
+		_ = websocket.Message.Send(ws, `{"orderId":"other-order","status":"failed"}`)
+		_ = websocket.Message.Send(ws, `{"orderId":"order-123","status":"active","appliedPercentage":50}`)
+		var discard string
+		_ = websocket.Message.Receive(ws, &discard)
+	}))
+	defer s.Close()
+
+	updated := make(chan v1alpha1.PortOrderObservation, 1)
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			portOrder().DeepCopyInto(obj.(*v1alpha1.PortOrder))
+			return nil
+		},
+		MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+			updated <- obj.(*v1alpha1.PortOrder).Status.AtProvider
+			return nil
+		},
+	}
+
+	m := newStreamManager(kube, logging.NewNopLogger(), nil)
+	cr := portOrder()
+	m.Ensure(cr, streamRequest{
+		url:       "ws" + strings.TrimPrefix(s.URL, "http"),
+		prepare:   withStreamHeaders(nil),
+		client:    testStreamer(t),
+		websocket: true,
+		orderID:   testOrderID,
+	})
+	defer m.Stop(cr.GetUID())
+
+	select {
+	case got := <-updated:
+		if got.Status != "active" || got.AppliedPercentage == nil || *got.AppliedPercentage != 50 {
+			t.Fatalf("websocket status update: want active at 50%%, got %+v", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("websocket status update: timed out")
+	}
+
+	if !m.Connected(cr.GetUID()) {
+		t.Fatal("m.Connected(...): want true, got false")
+	}
+	m.Stop(cr.GetUID())
+	if m.Connected(cr.GetUID()) {
+		t.Fatal("m.Connected(...) after m.Stop(...): want false, got true")
+	}
+}
+
+func Test_streamManager_Ensure(t *testing.T) {
+	// The server holds every stream open, reporting the order it follows.
+	opened := make(chan string, 4)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		opened <- r.URL.Path
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer s.Close()
+
+	awaitOpened := func(want string) {
+		t.Helper()
+		select {
+		case got := <-opened:
+			if got != want {
+				t.Fatalf("stream opened: want %s, got %s", want, got)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("stream opened: timed out waiting for %s", want)
+		}
+	}
+
+	m := newStreamManager(&test.MockClient{}, logging.NewNopLogger(), nil)
+	cr := portOrder()
+	req := func(orderID string) streamRequest {
+		return streamRequest{url: s.URL + "/" + orderID, prepare: withStreamHeaders(nil), client: testStreamer(t), orderID: orderID}
+	}
+	defer m.Stop(cr.GetUID())
+
+	m.Ensure(cr, req("order-1"))
+	awaitOpened("/order-1")
+	first := m.streams[cr.GetUID()]
+
+	// The same stream is kept.
+	m.Ensure(cr, req("order-1"))
+	if m.streams[cr.GetUID()] != first {
+		t.Fatal("m.Ensure(...) with the same order: want the stream to be kept")
+	}
+
+	// A stream following another order replaces it.
+	m.Ensure(cr, req("order-2"))
+	awaitOpened("/order-2")
+	second := m.streams[cr.GetUID()]
+	if second == first {
+		t.Fatal("m.Ensure(...) with another order: want the stream to be replaced")
+	}
+
+	// The replaced stream exiting does not stop the one that replaced it.
+	m.release(cr.GetUID(), first)
+	if m.streams[cr.GetUID()] != second {
+		t.Fatal("m.release(...) of the replaced stream: want the current stream to be kept")
+	}
+	m.setConnected(cr.GetUID(), first, true)
+	if m.Connected(cr.GetUID()) {
+		t.Fatal("m.setConnected(...) of the replaced stream: want no effect")
+	}
+}
+
+func Test_streamManager_Ensure_Mode(t *testing.T) {
+	m := newStreamManager(&test.MockClient{}, logging.NewNopLogger(), nil)
+	cr := portOrder()
+	defer m.Stop(cr.GetUID())
+
+	req := streamRequest{url: "http://127.0.0.1:1/orders", prepare: withStreamHeaders(nil), client: testStreamer(t), orderID: testOrderID}
+	m.Ensure(cr, req)
+	events := m.streams[cr.GetUID()]
+
+	req.websocket = true
+	m.Ensure(cr, req)
+	if m.streams[cr.GetUID()] == events {
+		t.Fatal("m.Ensure(...) over a WebSocket: want the event stream to be replaced")
+	}
+}
+
+func Test_streamManager_apply_StatusPath(t *testing.T) {
+	var got string
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			portOrder(func(po *v1alpha1.PortOrder) {
+				po.Spec.ForProvider.StatusPath = "state.phase"
+			}).DeepCopyInto(obj.(*v1alpha1.PortOrder))
+			return nil
+		},
+		MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+			got = obj.(*v1alpha1.PortOrder).Status.AtProvider.Status
+			return nil
+		},
+	}
+
+	m := newStreamManager(kube, logging.NewNopLogger(), nil)
+	if err := m.apply(context.Background(), "test", `{"orderId":"order-123","state":{"phase":"active"}}`); err != nil {
+		t.Fatalf("m.apply(...): %v", err)
+	}
+	if diff := cmp.Diff("active", got); diff != "" {
+		t.Errorf("m.apply(...): -want status, +got status: %s", diff)
+	}
+}
+
+func Test_external_syncStream_Request(t *testing.T) {
+	got := make(chan http.Header, 1)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case got <- r.Header.Clone():
+		default:
+		}
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer s.Close()
+
+	e := &external{
+		client:   testStreamer(t).(httpclient.Client),
+		logger:   logging.NewNopLogger(),
+		streams:  newStreamManager(&test.MockClient{}, logging.NewNopLogger(), nil),
+		tenantID: "tenant-1",
+		hmac:     &hmacSigner{primary: "key"},
+	}
+	cr := portOrder(func(po *v1alpha1.PortOrder) {
+		po.Spec.ForProvider.StreamStatus = true
+		po.Status.AtProvider.OrderID = testOrderID
+	})
+	defer e.streams.Stop(cr.GetUID())
+
+	if err := e.syncStream(cr, s.URL); err != nil {
+		t.Fatalf("e.syncStream(...): %v", err)
+	}
+	select {
+	case h := <-got:
+		if len(h[signatureHeader]) == 0 {
+			t.Errorf("stream request: want the %s header, got %v", signatureHeader, h)
+		}
+		if h.Get(tenantIDHeader) != "tenant-1" {
+			t.Errorf("stream request: want the %s header, got %v", tenantIDHeader, h)
+		}
+		if h.Get("Accept") != "text/event-stream" {
+			t.Errorf("stream request: want Accept text/event-stream, got %v", h)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream request: timed out")
+	}
+}
+
+func Test_webSocketEndpoint(t *testing.T) {
+	type want struct {
+		endpoint string
+		err      error
+	}
+
+	cases := map[string]struct {
+		params v1alpha1.PortOrderParameters
+		want   want
+	}{
+		"DerivedFromHTTPS": {
+			params: v1alpha1.PortOrderParameters{APIEndpoint: "https://api.example.com/orders"},
+			want:   want{endpoint: "wss://api.example.com/orders"},
+		},
+		"DerivedFromHTTP": {
+			params: v1alpha1.PortOrderParameters{ObserveEndpoint: "http://read.example.com/orders"},
+			want:   want{endpoint: "ws://read.example.com/orders"},
+		},
+		"Explicit": {
+			params: v1alpha1.PortOrderParameters{WebSocketEndpoint: "wss://events.example.com/subscribe"},
+			want:   want{endpoint: "wss://events.example.com/subscribe"},
+		},
+		"InvalidScheme": {
+			params: v1alpha1.PortOrderParameters{WebSocketEndpoint: "https://events.example.com"},
+			want:   want{err: errors.Errorf(errWebSocketEndpoint, "https://events.example.com")},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			got, err := webSocketEndpoint(tc.params)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("webSocketEndpoint(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.endpoint, got); diff != "" {
+				t.Fatalf("webSocketEndpoint(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}
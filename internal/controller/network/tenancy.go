@@ -0,0 +1,60 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	tenantIDHeader = "X-Tenant-ID"
+
+	errTenantIDRequired = "tenantId is required in multi-tenant mode"
+	errTenantIDInvalid  = "tenantId %q does not match %q"
+)
+
+// defaultTenantIDPattern matches a UUID.
+var defaultTenantIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// tenancy enforces the tenant isolation settings of the provider.
+type tenancy struct {
+	// required rejects orders without a tenant ID.
+	required bool
+	// pattern validates tenant IDs. Defaults to a UUID.
+	pattern *regexp.Regexp
+}
+
+// validate checks the tenant ID of an order.
+func (t tenancy) validate(tenantID string) error {
+	if tenantID == "" {
+		if t.required {
+			return errors.New(errTenantIDRequired)
+		}
+		return nil
+	}
+
+	pattern := t.pattern
+	if pattern == nil {
+		pattern = defaultTenantIDPattern
+	}
+	if !pattern.MatchString(tenantID) {
+		return errors.Errorf(errTenantIDInvalid, tenantID, pattern.String())
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+const testTenantID = "6f1c1f4e-4d2a-4b7e-9a4f-0c6d2b1e8f10"
+
+func Test_tenancy_validate(t *testing.T) {
+	cases := map[string]struct {
+		tenancy  tenancy
+		tenantID string
+		want     error
+	}{
+		"OptionalAndMissing": {},
+		"RequiredAndMissing": {
+			tenancy: tenancy{required: true},
+			want:    errors.New(errTenantIDRequired),
+		},
+		"UUID": {
+			tenancy:  tenancy{required: true},
+			tenantID: testTenantID,
+		},
+		"NotUUID": {
+			tenantID: "tenant-a",
+			want:     errors.Errorf(errTenantIDInvalid, "tenant-a", defaultTenantIDPattern.String()),
+		},
+		"CustomPattern": {
+			tenancy:  tenancy{pattern: regexp.MustCompile(`^tenant-[a-z]+$`)},
+			tenantID: "tenant-a",
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			err := tc.tenancy.validate(tc.tenantID)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("validate(...): -want error, +got error: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_send_TenantID(t *testing.T) {
+	var got []string
+	e := &external{
+		client: &MockHttpClient{
+			MockSendRequest: func(_ context.Context, _ string, _ string, _ httpclient.Data, headers httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+				got = headers.Decrypted.(map[string][]string)[tenantIDHeader]
+				return httpclient.HttpDetails{}, nil
+			},
+		},
+		logger:   logging.NewNopLogger(),
+		tenantID: testTenantID,
+	}
+
+	if _, err := e.send(context.Background(), "GET", "https://api.example.com/orders/order-123", "", nil); err != nil {
+		t.Fatalf("e.send(...): %v", err)
+	}
+	if diff := cmp.Diff([]string{testTenantID}, got); diff != "" {
+		t.Fatalf("e.send(...): -want %s, +got %s: %s", tenantIDHeader, tenantIDHeader, diff)
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+)
+
+const tracerName = "github.com/crossplane-contrib/provider-http/internal/controller/network"
+
+// Span attributes of PortOrder operations.
+const (
+	attrName       = attribute.Key("portorder.name")
+	attrOrderID    = attribute.Key("portorder.order_id")
+	attrHTTPStatus = attribute.Key("http.response.status_code")
+	attrOutcome    = attribute.Key("portorder.outcome")
+)
+
+// Outcomes of PortOrder operations.
+const (
+	outcomeError    = "error"
+	outcomeExists   = "exists"
+	outcomeNotFound = "not_found"
+	outcomeCreated  = "created"
+	outcomeUpdated  = "updated"
+	outcomeDeleted  = "deleted"
+)
+
+// tracer returns the tracer of the supplied provider, falling back to the
+// global provider, which does nothing unless an exporter is registered.
+func tracer(tp trace.TracerProvider) trace.Tracer {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	return tp.Tracer(tracerName)
+}
+
+// tracedExternal wraps an ExternalClient, recording a span for each
+// operation. The span is carried by the context passed to the wrapped
+// client, so it is the parent of any span created while sending requests.
+type tracedExternal struct {
+	managed.ExternalClient
+	tracer trace.Tracer
+}
+
+func (t *tracedExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	ctx, span := t.start(ctx, "Observe", mg)
+	obs, err := t.ExternalClient.Observe(ctx, mg)
+
+	outcome := outcomeNotFound
+	if obs.ResourceExists {
+		outcome = outcomeExists
+	}
+	t.end(span, mg, outcome, err)
+	return obs, err
+}
+
+func (t *tracedExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	ctx, span := t.start(ctx, "Create", mg)
+	c, err := t.ExternalClient.Create(ctx, mg)
+	t.end(span, mg, outcomeCreated, err)
+	return c, err
+}
+
+func (t *tracedExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	ctx, span := t.start(ctx, "Update", mg)
+	u, err := t.ExternalClient.Update(ctx, mg)
+	t.end(span, mg, outcomeUpdated, err)
+	return u, err
+}
+
+func (t *tracedExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	ctx, span := t.start(ctx, "Delete", mg)
+	err := t.ExternalClient.Delete(ctx, mg)
+	t.end(span, mg, outcomeDeleted, err)
+	return err
+}
+
+func (t *tracedExternal) start(ctx context.Context, op string, mg resource.Managed) (context.Context, trace.Span) {
+	return t.tracer.Start(ctx, "PortOrder."+op,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrName.String(mg.GetName())))
+}
+
+// end records the outcome of the operation and the order state it left
+// behind, then ends the span.
+func (t *tracedExternal) end(span trace.Span, mg resource.Managed, outcome string, err error) {
+	defer span.End()
+
+	if cr, ok := mg.(*v1alpha1.PortOrder); ok {
+		if id := cr.Status.AtProvider.OrderID; id != "" {
+			span.SetAttributes(attrOrderID.String(id))
+		}
+		if code := cr.Status.AtProvider.LastResponseStatus; code != 0 {
+			span.SetAttributes(attrHTTPStatus.Int(code))
+		}
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		outcome = outcomeError
+	}
+	span.SetAttributes(attrOutcome.String(outcome))
+}
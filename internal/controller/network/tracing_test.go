@@ -0,0 +1,138 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+func Test_tracedExternal(t *testing.T) {
+	type want struct {
+		name   string
+		status codes.Code
+		attrs  map[attribute.Key]attribute.Value
+	}
+
+	withOrderID := func(po *v1alpha1.PortOrder) {
+		po.Status.AtProvider.OrderID = testOrderID
+	}
+
+	cases := map[string]struct {
+		http httpclient.Client
+		op   func(ctx context.Context, e managed.ExternalClient, cr *v1alpha1.PortOrder) error
+		cr   *v1alpha1.PortOrder
+		want want
+	}{
+		"ObserveExists": {
+			http: &MockHttpClient{MockSendRequest: respondWith(200, `{"orderId":"order-123","status":"active"}`)},
+			op: func(ctx context.Context, e managed.ExternalClient, cr *v1alpha1.PortOrder) error {
+				_, err := e.Observe(ctx, cr)
+				return err
+			},
+			cr: portOrder(withOrderID),
+			want: want{
+				name:   "PortOrder.Observe",
+				status: codes.Unset,
+				attrs: map[attribute.Key]attribute.Value{
+					attrName:       attribute.StringValue(testPortOrderName),
+					attrOrderID:    attribute.StringValue(testOrderID),
+					attrHTTPStatus: attribute.IntValue(200),
+					attrOutcome:    attribute.StringValue(outcomeExists),
+				},
+			},
+		},
+		"CreateError": {
+			http: &MockHttpClient{MockSendRequest: respondWith(500, "oops")},
+			op: func(ctx context.Context, e managed.ExternalClient, cr *v1alpha1.PortOrder) error {
+				_, err := e.Create(ctx, cr)
+				return err
+			},
+			cr: portOrder(),
+			want: want{
+				name:   "PortOrder.Create",
+				status: codes.Error,
+				attrs: map[attribute.Key]attribute.Value{
+					attrName:       attribute.StringValue(testPortOrderName),
+					attrHTTPStatus: attribute.IntValue(500),
+					attrOutcome:    attribute.StringValue(outcomeError),
+				},
+			},
+		},
+		"Delete": {
+			http: &MockHttpClient{MockSendRequest: respondWith(204, "")},
+			op: func(ctx context.Context, e managed.ExternalClient, cr *v1alpha1.PortOrder) error {
+				return e.Delete(ctx, cr)
+			},
+			cr: portOrder(withOrderID),
+			want: want{
+				name:   "PortOrder.Delete",
+				status: codes.Unset,
+				attrs: map[attribute.Key]attribute.Value{
+					attrName:       attribute.StringValue(testPortOrderName),
+					attrOrderID:    attribute.StringValue(testOrderID),
+					attrHTTPStatus: attribute.IntValue(204),
+					attrOutcome:    attribute.StringValue(outcomeDeleted),
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			sr := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+
+			e := &tracedExternal{
+				ExternalClient: &external{client: tc.http, logger: logging.NewNopLogger()},
+				tracer:         tracer(tp),
+			}
+			_ = tc.op(context.Background(), e, tc.cr)
+
+			spans := sr.Ended()
+			if len(spans) != 1 {
+				t.Fatalf("ended spans: want 1, got %d", len(spans))
+			}
+			got := want{
+				name:   spans[0].Name(),
+				status: spans[0].Status().Code,
+				attrs:  map[attribute.Key]attribute.Value{},
+			}
+			for _, kv := range spans[0].Attributes() {
+				got.attrs[kv.Key] = kv.Value
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), cmp.Comparer(func(a, b attribute.Value) bool {
+				return a == b
+			})); diff != "" {
+				t.Fatalf("span: -want, +got: %s", diff)
+			}
+		})
+	}
+}
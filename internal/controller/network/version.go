@@ -0,0 +1,75 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+const errRefreshVersion = "cannot read the current version of order %s"
+
+// recordVersion records the version of the order with the supplied ID, if
+// the backend reported one.
+func recordVersion(cr *v1alpha1.PortOrder, id, version string) {
+	if version == "" {
+		return
+	}
+	if cr.Status.AtProvider.Versions == nil {
+		cr.Status.AtProvider.Versions = map[string]string{}
+	}
+	cr.Status.AtProvider.Versions[id] = version
+}
+
+// ifMatch returns the headers that make a modification of the order with the
+// supplied ID conditional on its last observed version, if any.
+func ifMatch(cr *v1alpha1.PortOrder, id string) map[string][]string {
+	v := cr.Status.AtProvider.Versions[id]
+	if v == "" {
+		return nil
+	}
+	return map[string][]string{"If-Match": {v}}
+}
+
+// patchOrder sends a modification of the order with the supplied ID, made
+// conditional on the version of the order last observed. If the backend
+// rejects it with 412 Precondition Failed, since the order changed since,
+// the order is read again and the modification sent once more with its
+// current version.
+func (e *external) patchOrder(ctx context.Context, cr *v1alpha1.PortOrder, endpoint, id, body string) (httpclient.HttpDetails, error) {
+	details, err := e.send(ctx, http.MethodPatch, orderURL(endpoint, id), body, ifMatch(cr, id))
+	if err != nil || details.HttpResponse.StatusCode != http.StatusPreconditionFailed {
+		return details, err
+	}
+
+	e.logger.Debug("PortOrder changed at the backend, retrying with its current version", "name", cr.GetName(), "orderID", id)
+	e.orders.invalidate(id)
+	order, err := e.getOrder(ctx, cr, observeEndpoint(cr.Spec.ForProvider), id)
+	if err != nil {
+		return httpclient.HttpDetails{}, errors.Wrapf(err, errRefreshVersion, id)
+	}
+	if order == nil {
+		return details, nil
+	}
+	recordVersion(cr, id, order.Version)
+	return e.send(ctx, http.MethodPatch, orderURL(endpoint, id), body, ifMatch(cr, id))
+}
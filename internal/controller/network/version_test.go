@@ -0,0 +1,182 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+func Test_external_Observe_Version(t *testing.T) {
+	cases := map[string]struct {
+		body    string
+		headers map[string][]string
+		want    map[string]string
+	}{
+		"NoVersion": {
+			body: `{"orderId":"order-123","status":"active"}`,
+		},
+		"Body": {
+			body:    `{"orderId":"order-123","status":"active","version":"7"}`,
+			headers: map[string][]string{"Etag": {`"abc"`}},
+			want:    map[string]string{testOrderID: "7"},
+		},
+		"ETag": {
+			body:    `{"orderId":"order-123","status":"active"}`,
+			headers: map[string][]string{"Etag": {`"abc"`}},
+			want:    map[string]string{testOrderID: `"abc"`},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: func(_ context.Context, _ string, _ string, _ httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+					return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 200, Body: tc.body, Headers: tc.headers}}, nil
+				}},
+				logger: logging.NewNopLogger(),
+			}
+			cr := portOrder(withObservedEndpoints("", ""))
+
+			if _, err := e.Observe(context.Background(), cr); err != nil {
+				t.Fatalf("e.Observe(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, cr.Status.AtProvider.Versions); diff != "" {
+				t.Fatalf("e.Observe(...): -want Versions, +got Versions: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_external_Update_Version(t *testing.T) {
+	type request struct {
+		method  string
+		ifMatch string
+	}
+	type want struct {
+		err      error
+		requests []request
+		version  string
+	}
+
+	cases := map[string]struct {
+		version string
+		// patchCodes are the status codes of the successive PATCH requests.
+		patchCodes []int
+		want       want
+	}{
+		"Unversioned": {
+			patchCodes: []int{200},
+			want: want{
+				requests: []request{{method: http.MethodPatch}},
+			},
+		},
+		"Matched": {
+			version:    "1",
+			patchCodes: []int{200},
+			want: want{
+				requests: []request{{method: http.MethodPatch, ifMatch: "1"}},
+				version:  "1",
+			},
+		},
+		"PreconditionFailedThenRefetched": {
+			version:    "1",
+			patchCodes: []int{412, 200},
+			want: want{
+				requests: []request{
+					{method: http.MethodPatch, ifMatch: "1"},
+					{method: http.MethodGet},
+					{method: http.MethodPatch, ifMatch: "2"},
+				},
+				version: "2",
+			},
+		},
+		"PreconditionFailedTwice": {
+			version:    "1",
+			patchCodes: []int{412, 412},
+			want: want{
+				err: errors.Errorf(errAmendStatusCode, 412, ""),
+				requests: []request{
+					{method: http.MethodPatch, ifMatch: "1"},
+					{method: http.MethodGet},
+					{method: http.MethodPatch, ifMatch: "2"},
+				},
+				version: "2",
+			},
+		},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			var requests []request
+			patches := 0
+			e := &external{
+				client: &MockHttpClient{MockSendRequest: func(_ context.Context, method string, _ string, _ httpclient.Data, headers httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+					r := request{method: method}
+					if v := headers.Decrypted.(map[string][]string)["If-Match"]; len(v) > 0 {
+						r.ifMatch = v[0]
+					}
+					requests = append(requests, r)
+					if method == http.MethodGet {
+						return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 200, Body: `{"orderId":"order-123","status":"active","version":"2"}`}}, nil
+					}
+					code := tc.patchCodes[patches]
+					patches++
+					return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: code}}, nil
+				}},
+				logger: logging.NewNopLogger(),
+			}
+			cr := portOrder(withObservedEndpoints("10.9.9.0/24", "10.0.1.0/24"))
+			recordVersion(cr, testOrderID, tc.version)
+
+			_, err := e.Update(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("e.Update(...): -want error, +got error: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.requests, requests, cmp.AllowUnexported(request{})); diff != "" {
+				t.Errorf("e.Update(...): -want requests, +got requests: %s", diff)
+			}
+			if diff := cmp.Diff(tc.want.version, cr.Status.AtProvider.Versions[testOrderID]); diff != "" {
+				t.Errorf("e.Update(...): -want version, +got version: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_ifMatch(t *testing.T) {
+	cr := portOrder(func(po *v1alpha1.PortOrder) {
+		po.Status.AtProvider.Versions = map[string]string{testOrderID: `"abc"`}
+	})
+	if diff := cmp.Diff(map[string][]string{"If-Match": {`"abc"`}}, ifMatch(cr, testOrderID)); diff != "" {
+		t.Errorf("ifMatch(...): -want, +got: %s", diff)
+	}
+	if got := ifMatch(cr, "order-456"); got != nil {
+		t.Errorf("ifMatch(...): want nil, got %v", got)
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+const (
+	errWebSocketEndpoint  = "WebSocket endpoint %q must use the ws or wss scheme"
+	errWebSocketConfig    = "cannot configure WebSocket connection"
+	errWebSocketSubscribe = "cannot subscribe to order updates"
+)
+
+// webSocketSubscription is the message that subscribes to the updates of an
+// order.
+type webSocketSubscription struct {
+	Type    string `json:"type"`
+	OrderID string `json:"orderId"`
+}
+
+// webSocketEndpoint returns the endpoint order updates are subscribed at.
+func webSocketEndpoint(p v1alpha1.PortOrderParameters) (string, error) {
+	endpoint := p.WebSocketEndpoint
+	if endpoint == "" {
+		u, err := url.Parse(observeEndpoint(p))
+		if err != nil {
+			return "", errors.Wrapf(err, errEndpointInvalid, observeEndpoint(p))
+		}
+		u.Scheme = map[string]string{"http": "ws", "https": "wss"}[u.Scheme]
+		endpoint = u.String()
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", errors.Wrapf(err, errEndpointInvalid, endpoint)
+	}
+	if u.Scheme != "ws" && u.Scheme != "wss" {
+		return "", errors.Errorf(errWebSocketEndpoint, endpoint)
+	}
+	if u.Hostname() == "" {
+		return "", errors.Errorf(errEndpointHost, endpoint)
+	}
+	return endpoint, nil
+}
+
+// consumeWebSocket subscribes to the updates of the order and applies them
+// until the connection ends.
+func (m *streamManager) consumeWebSocket(ctx context.Context, name string, req streamRequest, endpoint string, headers httpclient.Data, connected func()) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return errors.Wrap(err, errWebSocketConfig)
+	}
+	origin := *u
+	origin.Scheme = map[string]string{"ws": "http", "wss": "https"}[u.Scheme]
+	origin.Path, origin.RawQuery = "", ""
+
+	cfg, err := websocket.NewConfig(endpoint, origin.String())
+	if err != nil {
+		return errors.Wrap(err, errWebSocketConfig)
+	}
+	cfg.Header, cfg.TlsConfig = req.client.ConnectionConfig(headers, false)
+
+	ws, err := cfg.DialContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer ws.Close() //nolint:errcheck // nothing to do about a failed close.
+
+	// Reads do not observe the context, so close the connection to stop
+	// them when the stream is stopped.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = ws.Close()
+		case <-done:
+		}
+	}()
+
+	if err := websocket.JSON.Send(ws, webSocketSubscription{Type: "subscribe", OrderID: req.orderID}); err != nil {
+		return errors.Wrap(err, errWebSocketSubscribe)
+	}
+	connected()
+
+	for {
+		var data string
+		if err := websocket.Message.Receive(ws, &data); err != nil {
+			return err
+		}
+
+		// The connection may carry updates of other orders.
+		var event OrderResponse
+		if decodeJSON(data, &event) == nil && len(event.OrderID) > 0 && !event.OrderID.has(req.orderID) {
+			continue
+		}
+
+		if err := m.apply(ctx, name, data); err != nil {
+			return err
+		}
+	}
+}
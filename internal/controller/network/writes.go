@@ -0,0 +1,70 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+const errWriteSlot = "cannot wait for a free write slot"
+
+// writeLimiter bounds how many requests that change orders are sent at
+// once. A nil writeLimiter does not limit them.
+type writeLimiter struct {
+	slots chan struct{}
+}
+
+// newWriteLimiter returns a writeLimiter allowing max concurrent writes, or
+// nil if max is not positive.
+func newWriteLimiter(max int) *writeLimiter {
+	if max <= 0 {
+		return nil
+	}
+	return &writeLimiter{slots: make(chan struct{}, max)}
+}
+
+// acquire waits for a free write slot, returning a function that frees it.
+func (l *writeLimiter) acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return func() { <-l.slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// isWriteMethod reports whether requests with the supplied method change
+// orders.
+func isWriteMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// isMutation reports whether the GraphQL document is a mutation, which
+// changes orders, rather than a query.
+func isMutation(document string) bool {
+	return strings.HasPrefix(strings.TrimSpace(document), "mutation")
+}
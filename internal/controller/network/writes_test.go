@@ -0,0 +1,132 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-http/apis/network/v1alpha1"
+	httpclient "github.com/crossplane-contrib/provider-http/internal/clients/http"
+)
+
+func Test_external_send_MaxConcurrentWrites(t *testing.T) {
+	const max = 2
+
+	var mu sync.Mutex
+	inFlight, peak := 0, 0
+	e := &external{
+		client: &MockHttpClient{MockSendRequest: func(_ context.Context, _ string, _ string, _ httpclient.Data, _ httpclient.Data, _ bool) (httpclient.HttpDetails, error) {
+			mu.Lock()
+			inFlight++
+			if inFlight > peak {
+				peak = inFlight
+			}
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+			return httpclient.HttpDetails{HttpResponse: httpclient.HttpResponse{StatusCode: 200}}, nil
+		}},
+		logger: logging.NewNopLogger(),
+		writes: newWriteLimiter(max),
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3*max; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := e.send(context.Background(), http.MethodPost, testEndpoint, "{}", nil); err != nil {
+				t.Errorf("e.send(...): %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if peak != max {
+		t.Fatalf("e.send(...): want at most %d concurrent writes, got %d", max, peak)
+	}
+}
+
+func Test_external_send_ReadsNotLimited(t *testing.T) {
+	e := &external{
+		client: &MockHttpClient{MockSendRequest: respondWith(200, `{"data":{"order":{"orderId":"order-123","status":"active"}}}`)},
+		logger: logging.NewNopLogger(),
+		writes: newWriteLimiter(1),
+	}
+
+	// Hold the only write slot.
+	release, err := e.writes.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("e.writes.acquire(...): %v", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if _, err := e.send(ctx, http.MethodGet, testEndpoint, "", nil); err != nil {
+		t.Fatalf("e.send(GET): %v", err)
+	}
+	p := v1alpha1.PortOrderParameters{Protocol: v1alpha1.ProtocolGraphQL}
+	if _, err := e.sendGraphQL(ctx, testEndpoint, observeQuery(p), nil, nil); err != nil {
+		t.Fatalf("e.sendGraphQL(query): %v", err)
+	}
+
+	// Writes wait for the held slot.
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = e.sendGraphQL(ctx, testEndpoint, createMutation(p), nil, nil)
+	if diff := cmp.Diff(errors.Wrap(context.DeadlineExceeded, errWriteSlot), err, test.EquateErrors()); diff != "" {
+		t.Fatalf("e.sendGraphQL(mutation): -want error, +got error: %s", diff)
+	}
+}
+
+func Test_isWriteMethod(t *testing.T) {
+	cases := map[string]struct {
+		method string
+		want   bool
+	}{
+		"Get":    {method: http.MethodGet},
+		"Head":   {method: http.MethodHead},
+		"Post":   {method: http.MethodPost, want: true},
+		"Patch":  {method: http.MethodPatch, want: true},
+		"Delete": {method: http.MethodDelete, want: true},
+	}
+	for name, tc := range cases {
+		tc := tc // Create local copies of loop variables
+
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, isWriteMethod(tc.method)); diff != "" {
+				t.Fatalf("isWriteMethod(...): -want, +got: %s", diff)
+			}
+		})
+	}
+}